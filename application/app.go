@@ -1,39 +1,146 @@
 package application
 
 import (
+	"context"
+	"io"
 	"sync"
+	"time"
 
+	"github.com/benchkram/bobc/pkg/archive"
 	"github.com/benchkram/bobc/pkg/artifact"
+	"github.com/benchkram/bobc/pkg/gc"
+	"github.com/benchkram/bobc/pkg/health"
+	"github.com/benchkram/bobc/pkg/organization"
+	"github.com/benchkram/bobc/pkg/principal"
 	"github.com/benchkram/bobc/pkg/project"
+	"github.com/benchkram/bobc/pkg/quota"
+	"github.com/benchkram/bobc/pkg/replication"
+	"github.com/benchkram/bobc/pkg/retention"
+	"github.com/benchkram/bobc/pkg/token"
+	"github.com/benchkram/bobc/pkg/upload"
+	"github.com/benchkram/bobc/pkg/user"
 	"github.com/google/uuid"
+	"golang.org/x/sync/singleflight"
 )
 
 type Application interface {
-	Projects() (_ []*project.P, err error)
-	Project(id uuid.UUID) (*project.P, error)
-	ProjectByName(name string) (_ *project.P, err error)
+	Projects(ctx context.Context) (_ []*project.P, err error)
+	Project(ctx context.Context, id uuid.UUID) (*project.P, error)
+	ProjectByName(ctx context.Context, name string) (_ *project.P, err error)
+	ProjectIDByName(ctx context.Context, name string) (uuid.UUID, error)
 	//ProjectsByName(name string) ([]*project.P, error)
-	ProjectExists(name string) (bool, error)
-	ProjectCreate(name, description string) (*project.P, error)
-	ProjectDelete(id uuid.UUID) error
-
-	ProjectArtifact(projectID uuid.UUID, artifactID string) (*artifact.A, error)
-	ProjectArtifactExists(projectID uuid.UUID, artifactID string) (bool, error)
-	ProjectArtifactCreate(projectID uuid.UUID, artifactID string, src string, size int) error
-	ProjectArtifactDelete(projectID uuid.UUID, artifactID string) error
+	ProjectExists(ctx context.Context, name string) (bool, error)
+	ProjectCreate(ctx context.Context, orgName, name, description string) (*project.P, error)
+	ProjectDelete(ctx context.Context, id uuid.UUID) error
+	ProjectVisibilitySet(ctx context.Context, id uuid.UUID, public bool) error
+	ProjectExport(ctx context.Context, id uuid.UUID, w io.Writer) error
+	ProjectImport(ctx context.Context, src io.Reader, path string) (*archive.Report, error)
+
+	ProjectArtifact(ctx context.Context, projectID uuid.UUID, artifactID string) (*artifact.A, error)
+	ProjectArtifactExists(ctx context.Context, projectID uuid.UUID, artifactID string) (exists bool, scope string, err error)
+	ProjectArtifactsExist(ctx context.Context, projectID uuid.UUID, artifactIDs []string) (present, missing []string, scopes map[string]string, err error)
+	ProjectArtifactCreate(ctx context.Context, projectID uuid.UUID, artifactID, digest string, src io.Reader) (*artifact.A, error)
+	ProjectArtifactDelete(ctx context.Context, projectID uuid.UUID, artifactID string) error
+
+	UploadCreate(ctx context.Context, projectID uuid.UUID, artifactID, digest string) (*upload.U, error)
+	DirectUploadCreate(ctx context.Context, projectID uuid.UUID, artifactID, digest string, parts int) (*upload.U, error)
+	Upload(ctx context.Context, projectID, uploadID uuid.UUID) (*upload.U, error)
+	UploadPart(ctx context.Context, projectID, uploadID uuid.UUID, number int, src io.Reader, size int64) error
+	UploadComplete(ctx context.Context, projectID, uploadID uuid.UUID) (*artifact.A, error)
+	UploadAbort(ctx context.Context, projectID, uploadID uuid.UUID) error
+	UploadsExpire() error
+
+	GarbageCollect(apply bool) (*gc.Report, error)
+
+	Replicate(target ReplicationTarget, projectPaths []string) (*replication.Report, error)
+
+	RetentionPolicy(ctx context.Context, projectID uuid.UUID) (*retention.Policy, error)
+	RetentionPolicySet(ctx context.Context, p *retention.Policy) error
+	RetentionEnforce() error
+
+	Quota(ctx context.Context, projectID uuid.UUID) (*quota.Q, error)
+	QuotaSet(ctx context.Context, q *quota.Q) error
+	ProjectUsage(ctx context.Context, projectID uuid.UUID) (quota.Usage, error)
+	ProjectsUsage() (map[string]quota.Usage, error)
+
+	Readiness(ctx context.Context) *health.Report
+
+	TokenCreate(ctx context.Context, name string, scope token.Scope, projectID, userID uuid.UUID, expiresAt time.Time) (_ *token.T, secret string, err error)
+	Tokens(ctx context.Context) ([]*token.T, error)
+	TokenRevoke(ctx context.Context, id uuid.UUID) error
+	TokenVerify(secret string) (*token.T, error)
+	TokenAuthenticate(secret string) (*principal.P, error)
+
+	UserCreate(ctx context.Context, name string) (*user.U, error)
+	Users(ctx context.Context) ([]*user.U, error)
+	UserByName(ctx context.Context, name string) (*user.U, error)
+	UserDelete(ctx context.Context, name string) error
+
+	OrganizationCreate(ctx context.Context, name, description string) (*organization.O, error)
+	Organizations(ctx context.Context) ([]*organization.O, error)
+	OrganizationByName(ctx context.Context, name string) (*organization.O, error)
+	OrganizationDelete(ctx context.Context, name string) error
+
+	Members(ctx context.Context, orgName string) ([]*organization.Member, error)
+	MemberSet(ctx context.Context, orgName, userName string, role organization.Role) (*organization.Member, error)
+	MemberRemove(ctx context.Context, orgName, userName string) error
 }
 
+// maxArtifactsExist limits the number of artifacts
+// checked by a single call to ProjectArtifactsExist.
+const maxArtifactsExist = 1000
+
 type application struct {
 	// projects is the storage abstraction for projects
 	projects ProjectRepository
 
+	// tokens is the storage abstraction for api tokens
+	tokens TokenRepository
+
+	// orgs is the storage abstraction for users, organizations and their members
+	orgs OrganizationRepository
+
+	// uploadExpiry is the time span a resumable upload
+	// must be completed in before it's discarded
+	uploadExpiry time.Duration
+
+	// gcGracePeriod is the minimum age of an object in the
+	// artifact store before it's considered orphaned
+	gcGracePeriod time.Duration
+
+	// scopeFallback are the scopes artifacts are read from when they
+	// are missing in the scope of a request, before the default scope
+	scopeFallback []string
+
+	// upstream is the server artifacts missing locally are fetched
+	// from, nil if the server doesn't proxy another one
+	upstream Upstream
+
+	// upstreamForward enables forwarding of uploads to the upstream
+	upstreamForward bool
+
+	// upstreamTimeout limits the time fetching an artifact from or
+	// forwarding one to the upstream may take, no limit if 0
+	upstreamTimeout time.Duration
+
+	// upstreamFetches deduplicates concurrent fetches of an artifact
+	upstreamFetches singleflight.Group
+
+	// upstreamForwards limits the number of parallel forwards
+	upstreamForwards chan struct{}
+
 	// mux is used to not allow specific operations to be called in parallel
 	mux sync.Mutex
 }
 
 func New(opts ...Option) Application {
 	// intialize defaults here
-	app := &application{}
+	app := &application{
+		uploadExpiry:  24 * time.Hour,
+		gcGracePeriod: time.Hour,
+
+		upstreamForwards: make(chan struct{}, maxUpstreamForwards),
+	}
 
 	for _, opt := range opts {
 		if opt != nil {
@@ -0,0 +1,193 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/benchkram/bobc/pkg/archive"
+	"github.com/benchkram/bobc/pkg/logging"
+	"github.com/benchkram/bobc/pkg/project"
+	"github.com/benchkram/bobc/pkg/projectrepo"
+	"github.com/benchkram/bobc/pkg/scope"
+	"github.com/benchkram/bobc/pkg/token"
+	"github.com/benchkram/bobc/pkg/tracing"
+	"github.com/benchkram/errz"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// ProjectExport writes an archive of a project, holding its
+// metadata and the artifacts of all scopes, to w.
+func (s *application) ProjectExport(ctx context.Context, projectID uuid.UUID, w io.Writer) (err error) {
+	ctx, span := tracing.Start(ctx, "application.ProjectExport")
+	defer tracing.End(span, &err)
+	defer errz.Recover(&err)
+
+	err = s.authorize(ctx, projectID, token.ScopeAdmin)
+	if err != nil {
+		return err
+	}
+
+	p, err := s.projects.Project(ctx, projectID)
+	if errors.Is(err, projectrepo.ErrNotFound) {
+		return ErrProjectNotFound
+	}
+	errz.Fatal(err)
+
+	artifacts, err := s.projects.ArtifactsSince(ctx, projectID, time.Time{})
+	errz.Fatal(err)
+
+	m := &archive.Manifest{
+		Project: archive.Project{
+			Organization: p.Organization,
+			Name:         p.Name,
+			Description:  p.Description,
+			Public:       p.Public,
+		},
+		Created: time.Now().UTC(),
+	}
+	for _, a := range artifacts {
+		m.Artifacts = append(m.Artifacts, archive.Artifact{
+			ID:             a.ID,
+			Scope:          a.Scope,
+			Size:           int64(a.Size),
+			Digest:         a.Digest,
+			CreatedAt:      a.CreatedAt,
+			LastAccessedAt: a.LastAccessedAt,
+		})
+	}
+
+	aw := archive.NewWriter(w)
+
+	err = aw.WriteManifest(m)
+	errz.Fatal(err)
+
+	for _, a := range artifacts {
+		err = s.exportArtifact(ctx, aw, projectID, a.Scope, a.ID)
+		errz.Fatal(err)
+	}
+
+	err = aw.Close()
+	errz.Fatal(err)
+
+	log.Ctx(ctx).Info().
+		Str(logging.FieldProjectID, projectID.String()).
+		Str(logging.FieldSubject, subject(ctx)).
+		Msg("Project exported.")
+
+	return nil
+}
+
+func (s *application) exportArtifact(ctx context.Context, aw *archive.Writer, projectID uuid.UUID, sc, artifactID string) (err error) {
+	defer errz.Recover(&err)
+
+	src, err := s.projects.ArtifactRead(ctx, projectID, sc, artifactID)
+	errz.Fatal(err)
+	defer src.Close()
+
+	return aw.WriteArtifact(src)
+}
+
+// ProjectImport reads an archive written by ProjectExport from src and creates
+// its artifacts like uploads do. The project is created if it doesn't exist,
+// along with its organization. Artifacts which already exist are skipped.
+// path overrides the path of the project stored in the archive if not empty.
+func (s *application) ProjectImport(ctx context.Context, src io.Reader, path string) (_ *archive.Report, err error) {
+	ctx, span := tracing.Start(ctx, "application.ProjectImport")
+	defer tracing.End(span, &err)
+	defer errz.Recover(&err)
+
+	ar, err := archive.NewReader(src)
+	if errors.Is(err, archive.ErrInvalidArchive) || errors.Is(err, archive.ErrUnsupportedVersion) {
+		return nil, ErrInvalidArchive
+	}
+	errz.Fatal(err)
+
+	m := ar.Manifest()
+	if path == "" {
+		path = project.Path(m.Project.Organization, m.Project.Name)
+	}
+
+	p, err := s.importProject(ctx, path, m.Project)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &archive.Report{Project: p.Path()}
+	for {
+		a, payload, err := ar.Next()
+		if err == io.EOF {
+			break
+		} else if errors.Is(err, archive.ErrInvalidArchive) {
+			return nil, ErrInvalidArchive
+		}
+		errz.Fatal(err)
+
+		_, err = s.ProjectArtifactCreate(scope.NewContext(ctx, a.Scope), p.ID, a.ID, a.Digest, payload)
+		if errors.Is(err, ErrArtifactAlreadyExists) {
+			report.Skipped++
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		report.Imported++
+
+		// keep the artifact's position for retention policies
+		if !a.LastAccessedAt.IsZero() {
+			err = s.projects.ArtifactAccessedSet(ctx, p.ID, a.Scope, a.ID, a.LastAccessedAt)
+			errz.Fatal(err)
+		}
+	}
+
+	if report.Imported+report.Skipped != len(m.Artifacts) {
+		return nil, ErrInvalidArchive
+	}
+
+	log.Ctx(ctx).Info().
+		Str(logging.FieldProjectID, p.ID.String()).
+		Str(logging.FieldSubject, subject(ctx)).
+		Int("imported", report.Imported).
+		Int("skipped", report.Skipped).
+		Msg("Project imported.")
+
+	return report, nil
+}
+
+// importProject returns the project at path, creating it
+// and its organization from the archived metadata if missing.
+func (s *application) importProject(ctx context.Context, path string, meta archive.Project) (_ *project.P, err error) {
+	defer errz.Recover(&err)
+
+	p, err := s.ProjectByName(ctx, path)
+	if err == nil {
+		return p, s.authorize(ctx, p.ID, token.ScopeAdmin)
+	} else if !errors.Is(err, ErrProjectNotFound) {
+		return nil, err
+	}
+
+	orgName, _ := project.SplitPath(path)
+	_, err = s.organization(orgName)
+	if errors.Is(err, ErrOrganizationNotFound) {
+		_, err = s.OrganizationCreate(ctx, orgName, "")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	p, err = s.ProjectCreate(ctx, "", path, meta.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	if meta.Public {
+		err = s.ProjectVisibilitySet(ctx, p.ID, true)
+		if err != nil {
+			return nil, err
+		}
+		p.Public = true
+	}
+
+	return p, nil
+}
@@ -1,60 +1,255 @@
 package application
 
 import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
 	"github.com/benchkram/bobc/pkg/artifact"
+	"github.com/benchkram/bobc/pkg/checksum"
+	"github.com/benchkram/bobc/pkg/logging"
+	"github.com/benchkram/bobc/pkg/projectrepo"
+	"github.com/benchkram/bobc/pkg/quota"
+	"github.com/benchkram/bobc/pkg/scope"
+	"github.com/benchkram/bobc/pkg/token"
+	"github.com/benchkram/bobc/pkg/tracing"
 	"github.com/benchkram/errz"
 	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
 )
 
-// ProjectArtifactCreate creates a new artifact and copies src to the internal storage.
-func (s *application) ProjectArtifactCreate(projectID uuid.UUID, artifactID string, src string, size int) (err error) {
+// ProjectArtifactCreate creates a new artifact and streams src to the internal storage.
+// If digest is not empty the artifact is only created when the sha256 checksum of src matches.
+// The quota of the project is checked before, the size of src is limited to the remaining bytes.
+func (s *application) ProjectArtifactCreate(ctx context.Context, projectID uuid.UUID, artifactID, digest string, src io.Reader) (_ *artifact.A, err error) {
+	ctx, span := tracing.Start(ctx, "application.ProjectArtifactCreate")
+	defer tracing.End(span, &err)
 	defer errz.Recover(&err)
 
-	exists, err := s.ProjectArtifactExists(projectID, artifactID)
-	errz.Fatal(err)
+	err = s.authorize(ctx, projectID, token.ScopeWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	digest, err = normalizeDigest(digest)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := s.artifactExists(ctx, projectID, artifactID)
+	if err != nil {
+		return nil, err
+	}
 
 	if exists {
-		return ErrArtifactAlreadyExists
+		return nil, ErrArtifactAlreadyExists
+	}
+
+	remaining, err := s.quotaRemaining(ctx, projectID, 0, 1)
+	if err != nil {
+		return nil, err
 	}
 
-	err = s.projects.CreateArtifact(projectID, artifactID, src, size)
+	qr := quota.NewReader(src, remaining)
+	a, err := s.projects.CreateArtifact(ctx, projectID, scope.FromContext(ctx), artifactID, digest, qr)
+	if qr.Exceeded() || errors.Is(err, projectrepo.ErrQuotaExceeded) {
+		return nil, ErrQuotaExceeded
+	} else if errors.Is(err, projectrepo.ErrDigestMismatch) {
+		return nil, ErrDigestMismatch
+	}
 	errz.Fatal(err)
 
-	return nil
+	log.Ctx(ctx).Info().
+		Str(logging.FieldProjectID, projectID.String()).
+		Str(logging.FieldArtifactID, a.ID).
+		Str(logging.FieldScope, a.Scope).
+		Int("size", a.Size).
+		Str("digest", a.Digest).
+		Str(logging.FieldSubject, subject(ctx)).
+		Msg("Artifact created.")
+
+	s.forwardUpstream(ctx, projectID, a)
+
+	return a, nil
+}
+
+// normalizeDigest validates an expected digest sent by a client.
+// An empty digest is valid and means no check is wanted.
+func normalizeDigest(digest string) (string, error) {
+	if digest == "" {
+		return "", nil
+	}
+
+	digest = strings.ToLower(digest)
+	if !checksum.Valid(digest) {
+		return "", ErrInvalidDigest
+	}
+
+	return digest, nil
 }
 
-// ProjectArtifactDelete deletes a artifact from database and s3 storage, does nothing if artifact does not exists
-func (s *application) ProjectArtifactDelete(projectID uuid.UUID, artifactID string) (err error) {
+// ProjectArtifactDelete deletes a artifact from database and s3 storage, does nothing if artifact does not exists.
+// Only the artifact in the scope of the request is deleted.
+func (s *application) ProjectArtifactDelete(ctx context.Context, projectID uuid.UUID, artifactID string) (err error) {
+	ctx, span := tracing.Start(ctx, "application.ProjectArtifactDelete")
+	defer tracing.End(span, &err)
 	defer errz.Recover(&err)
 
-	_, err = s.Project(projectID)
+	err = s.authorize(ctx, projectID, token.ScopeWrite)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.Project(ctx, projectID)
 	errz.Fatal(err)
 
-	err = s.projects.ProjectArtifactDelete(projectID, artifactID)
+	err = s.projects.ProjectArtifactDelete(ctx, projectID, scope.FromContext(ctx), artifactID)
 	errz.Fatal(err)
 
+	log.Ctx(ctx).Info().
+		Str(logging.FieldProjectID, projectID.String()).
+		Str(logging.FieldArtifactID, artifactID).
+		Str(logging.FieldScope, scope.FromContext(ctx)).
+		Str(logging.FieldSubject, subject(ctx)).
+		Msg("Artifact deleted.")
+
 	return nil
 }
 
-func (s *application) ProjectArtifactExists(projectID uuid.UUID, artifactID string) (_ bool, err error) {
+// ProjectArtifactExists reports if an artifact exists in any of the scopes
+// read by the request and the first of them containing it.
+// Artifacts missing locally are fetched from the upstream, if any.
+func (s *application) ProjectArtifactExists(ctx context.Context, projectID uuid.UUID, artifactID string) (_ bool, _ string, err error) {
+	ctx, span := tracing.Start(ctx, "application.ProjectArtifactExists")
+	defer tracing.End(span, &err)
 	defer errz.Recover(&err)
 
-	_, err = s.Project(projectID)
+	_, err = s.Project(ctx, projectID)
+	if err != nil {
+		return false, "", err
+	}
+
+	exists, sc, err := s.projects.ProjectArtifactExists(ctx, projectID, s.readScopes(ctx), artifactID)
+	errz.Fatal(err)
+
+	if exists || s.upstream == nil {
+		return exists, sc, nil
+	}
+
+	sc, err = s.fetchUpstream(ctx, projectID, scope.FromContext(ctx), artifactID)
+	if errors.Is(err, projectrepo.ErrNotFound) {
+		return false, "", nil
+	}
+	errz.Fatal(err)
+
+	return true, sc, nil
+}
+
+// artifactExists reports if an artifact exists in the scope of the request.
+// Writes only consider this scope, artifacts of fallback scopes don't collide.
+func (s *application) artifactExists(ctx context.Context, projectID uuid.UUID, artifactID string) (_ bool, err error) {
+	defer errz.Recover(&err)
+
+	_, err = s.Project(ctx, projectID)
 	if err != nil {
 		return false, err
 	}
 
-	return s.projects.ProjectArtifactExists(projectID, artifactID)
+	exists, _, err := s.projects.ProjectArtifactExists(ctx, projectID, []string{scope.FromContext(ctx)}, artifactID)
+	return exists, err
+}
+
+// readScopes returns the scopes the request reads artifacts from, in order.
+func (s *application) readScopes(ctx context.Context) []string {
+	return scope.Chain(scope.FromContext(ctx), s.scopeFallback)
 }
 
-func (s *application) ProjectArtifact(projectID uuid.UUID, artifactID string) (_ *artifact.A, err error) {
+// ProjectArtifactsExist splits artifactIDs into those present in the project
+// and those missing, keeping their order. scopes maps the present artifacts to
+// the first of the scopes read by the request containing them.
+// Artifacts missing locally are looked up on the upstream, if any, and count
+// as present if found there. They are fetched once they are read.
+func (s *application) ProjectArtifactsExist(ctx context.Context, projectID uuid.UUID, artifactIDs []string) (present, missing []string, scopes map[string]string, err error) {
+	ctx, span := tracing.Start(ctx, "application.ProjectArtifactsExist")
+	defer tracing.End(span, &err)
 	defer errz.Recover(&err)
 
-	_, err = s.Project(projectID)
+	err = s.authorize(ctx, projectID, token.ScopeRead)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if len(artifactIDs) > maxArtifactsExist {
+		return nil, nil, nil, ErrTooManyArtifacts
+	}
+
+	scopes, err = s.projects.ProjectArtifactsExist(ctx, projectID, s.readScopes(ctx), artifactIDs)
 	errz.Fatal(err)
 
-	artifact, err := s.projects.ProjectArtifact(projectID, artifactID)
+	if s.upstream != nil {
+		notFound := []string{}
+		for _, id := range artifactIDs {
+			if _, ok := scopes[id]; !ok {
+				notFound = append(notFound, id)
+			}
+		}
+
+		if len(notFound) > 0 {
+			found, err := s.existsUpstream(ctx, projectID, notFound)
+			errz.Fatal(err)
+
+			for id, sc := range found {
+				scopes[id] = sc
+			}
+		}
+	}
+
+	present = []string{}
+	missing = []string{}
+	seen := map[string]bool{}
+	for _, id := range artifactIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		if _, ok := scopes[id]; ok {
+			present = append(present, id)
+		} else {
+			missing = append(missing, id)
+		}
+	}
+
+	return present, missing, scopes, nil
+}
+
+// ProjectArtifact returns an artifact along with a link to download it.
+// Artifacts missing locally are fetched from the upstream, if any.
+func (s *application) ProjectArtifact(ctx context.Context, projectID uuid.UUID, artifactID string) (_ *artifact.A, err error) {
+	ctx, span := tracing.Start(ctx, "application.ProjectArtifact")
+	defer tracing.End(span, &err)
+	defer errz.Recover(&err)
+
+	_, err = s.Project(ctx, projectID)
 	errz.Fatal(err)
 
+	artifact, err := s.projects.ProjectArtifact(ctx, projectID, s.readScopes(ctx), artifactID)
+	if errors.Is(err, projectrepo.ErrNotFound) && s.upstream != nil {
+		var sc string
+		sc, err = s.fetchUpstream(ctx, projectID, scope.FromContext(ctx), artifactID)
+		errz.Fatal(err)
+
+		artifact, err = s.projects.ProjectArtifact(ctx, projectID, []string{sc}, artifactID)
+	}
+	errz.Fatal(err)
+
+	// the access is relevant for retention policies only, don't fail on it
+	err = s.projects.ArtifactTouch(ctx, projectID, artifact.Scope, artifactID, time.Now())
+	if err != nil {
+		errz.Log(err)
+	}
+
 	return artifact, nil
 }
@@ -0,0 +1,83 @@
+package application
+
+import (
+	"context"
+	"errors"
+
+	"github.com/benchkram/bobc/pkg/principal"
+	"github.com/benchkram/bobc/pkg/projectrepo"
+	"github.com/benchkram/bobc/pkg/token"
+	"github.com/benchkram/errz"
+	"github.com/google/uuid"
+)
+
+// authorize checks if the principal carried by ctx has scope on the project.
+// Pass uuid.Nil for operations which don't target a single project.
+// The organization owning the project is only looked up for principals
+// whose grants are restricted to organizations. Public projects can be
+// read by anyone.
+func (s *application) authorize(ctx context.Context, projectID uuid.UUID, scope token.Scope) (err error) {
+	defer errz.Recover(&err)
+
+	p := principal.FromContext(ctx)
+	if p == nil {
+		return ErrUnauthenticated
+	}
+
+	if p.Allows(projectID, scope) {
+		return nil
+	}
+
+	if projectID != uuid.Nil && p.OrganizationScoped() {
+		orgID, err := s.projects.ProjectOrganizationID(ctx, projectID)
+		if err != nil && !errors.Is(err, projectrepo.ErrNotFound) {
+			errz.Fatal(err)
+		}
+
+		if err == nil && p.AllowsIn(orgID, projectID, scope) {
+			return nil
+		}
+	}
+
+	if projectID != uuid.Nil && scope == token.ScopeRead {
+		public, err := s.projects.ProjectPublic(ctx, projectID)
+		if err != nil && !errors.Is(err, projectrepo.ErrNotFound) {
+			errz.Fatal(err)
+		}
+
+		if public {
+			return nil
+		}
+	}
+
+	// ask for credentials instead of denying them
+	if p.Anonymous {
+		return ErrUnauthenticated
+	}
+
+	return ErrForbidden
+}
+
+// authorizeOrganization checks if the principal carried by ctx
+// has scope on the organization itself.
+func authorizeOrganization(ctx context.Context, orgID uuid.UUID, scope token.Scope) error {
+	p := principal.FromContext(ctx)
+	if p == nil {
+		return ErrUnauthenticated
+	}
+
+	if !p.AllowsIn(orgID, uuid.Nil, scope) {
+		return ErrForbidden
+	}
+
+	return nil
+}
+
+// subject identifies the principal carried by ctx in logs.
+func subject(ctx context.Context) string {
+	p := principal.FromContext(ctx)
+	if p == nil {
+		return "unknown"
+	}
+	return p.Subject
+}
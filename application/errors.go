@@ -5,13 +5,43 @@ import (
 )
 
 var (
+	ErrUnauthenticated = errors.New("unauthenticated")
+	ErrForbidden       = errors.New("forbidden")
+
 	ErrArtifactNotFound      = errors.New("artifact not found")
 	ErrArtifactAlreadyExists = errors.New("artifact already exists")
 	ErrProjectNotFound       = errors.New("project not found")
 	ErrProjectAlreadyExists  = errors.New("project already exists")
 	ErrTokenAlreadyExists    = errors.New("access token already exists")
+	ErrTokenNotFound         = errors.New("access token not found")
+	ErrInvalidToken          = errors.New("invalid access token")
+	ErrInvalidTokenName      = errors.New("invalid access token name")
+	ErrInvalidTokenScope     = errors.New("invalid access token scope")
+	ErrInvalidTokenExpiry    = errors.New("access token expiry in the past")
 	ErrUserNotFound          = errors.New("user not found")
 	ErrUserAlreadyExists     = errors.New("user already exists")
 	ErrInvalidUsername       = errors.New("invalid username")
+	ErrMemberNotFound        = errors.New("member not found")
+	ErrInvalidRole           = errors.New("invalid role")
 	ErrInvalidProjectName    = errors.New("invalid project name")
+	ErrTooManyArtifacts      = errors.New("too many artifacts")
+	ErrInvalidDigest         = errors.New("invalid digest")
+	ErrDigestMismatch        = errors.New("digest mismatch")
+
+	ErrOrganizationNotFound      = errors.New("organization not found")
+	ErrOrganizationAlreadyExists = errors.New("organization already exists")
+	ErrOrganizationNotEmpty      = errors.New("organization owns projects")
+	ErrInvalidOrganizationName   = errors.New("invalid organization name")
+
+	ErrInvalidRetentionPolicy = errors.New("invalid retention policy")
+	ErrInvalidQuota           = errors.New("invalid quota")
+	ErrQuotaExceeded          = errors.New("quota exceeded")
+	ErrUploadNotFound         = errors.New("upload not found")
+	ErrUploadIncomplete       = errors.New("upload incomplete")
+	ErrInvalidPartNumber      = errors.New("invalid part number")
+	ErrUploadDirect           = errors.New("upload is sent directly to the artifact store")
+
+	ErrDirectUploadUnsupported = errors.New("direct uploads not supported")
+
+	ErrInvalidArchive = errors.New("invalid archive")
 )
@@ -0,0 +1,33 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/benchkram/bobc/pkg/gc"
+	"github.com/benchkram/bobc/pkg/tracing"
+	"github.com/benchkram/errz"
+	"github.com/rs/zerolog/log"
+)
+
+// GarbageCollect finds objects in the artifact store without an artifact and
+// artifacts without an object. With apply set they are removed, otherwise
+// they are only reported. Objects younger than the grace period are ignored
+// as their artifact might still be in the process of being recorded.
+func (s *application) GarbageCollect(apply bool) (_ *gc.Report, err error) {
+	ctx, span := tracing.Start(context.Background(), "application.GarbageCollect")
+	defer tracing.End(span, &err)
+	defer errz.Recover(&err)
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	report, err := s.projects.Reconcile(ctx, time.Now().Add(-s.gcGracePeriod), apply)
+	errz.Fatal(err)
+
+	if !report.Empty() {
+		log.Info().Str("summary", report.Summary()).Msg("Garbage collection finished.")
+	}
+
+	return report, nil
+}
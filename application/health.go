@@ -0,0 +1,18 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/benchkram/bobc/pkg/health"
+)
+
+// readinessTimeout bounds each readiness check, probes
+// shouldn't wait for a dependency which doesn't respond.
+const readinessTimeout = 5 * time.Second
+
+// Readiness checks the dependencies of the server.
+// Not authorized, it's meant for probes like kubernetes.
+func (s *application) Readiness(ctx context.Context) *health.Report {
+	return health.Run(ctx, readinessTimeout, s.projects.Checks()...)
+}
@@ -1,22 +1,109 @@
 package application
 
 import (
+	"context"
+	"io"
+	"time"
+
 	"github.com/benchkram/bobc/pkg/artifact"
+	"github.com/benchkram/bobc/pkg/gc"
+	"github.com/benchkram/bobc/pkg/health"
+	"github.com/benchkram/bobc/pkg/organization"
 	"github.com/benchkram/bobc/pkg/project"
+	"github.com/benchkram/bobc/pkg/quota"
+	"github.com/benchkram/bobc/pkg/retention"
+	"github.com/benchkram/bobc/pkg/token"
+	"github.com/benchkram/bobc/pkg/upload"
+	"github.com/benchkram/bobc/pkg/user"
 	"github.com/google/uuid"
 )
 
 type ProjectRepository interface {
-	CreateOrUpdate(project *project.P) error
+	CreateOrUpdate(ctx context.Context, project *project.P) error
+
+	Project(ctx context.Context, id uuid.UUID) (*project.P, error)
+	ProjectByName(ctx context.Context, name string) (*project.P, error)
+	ProjectIDByName(ctx context.Context, name string) (uuid.UUID, error)
+	ProjectOrganizationID(ctx context.Context, id uuid.UUID) (uuid.UUID, error)
+	ProjectPublic(ctx context.Context, id uuid.UUID) (bool, error)
+	ProjectVisibilitySet(ctx context.Context, id uuid.UUID, public bool) error
+	Projects(ctx context.Context) ([]*project.P, error)
+	ProjectDelete(ctx context.Context, id uuid.UUID) error
+
+	CreateArtifact(ctx context.Context, projectID uuid.UUID, scope, artifactID, digest string, src io.Reader) (*artifact.A, error)
+	ProjectArtifact(ctx context.Context, projectID uuid.UUID, scopes []string, artifactID string) (*artifact.A, error)
+	ProjectArtifactDelete(ctx context.Context, projectID uuid.UUID, scope, artifactID string) error
+	ArtifactRead(ctx context.Context, projectID uuid.UUID, scope, artifactID string) (io.ReadCloser, error)
+
+	ProjectArtifactExists(ctx context.Context, projectID uuid.UUID, scopes []string, artifactID string) (bool, string, error)
+	ProjectArtifactsExist(ctx context.Context, projectID uuid.UUID, scopes []string, artifactIDs []string) (map[string]string, error)
+
+	UploadCreate(ctx context.Context, projectID uuid.UUID, scope, artifactID, digest string, expiresAt time.Time) (*upload.U, error)
+	DirectUploadCreate(ctx context.Context, projectID uuid.UUID, scope, artifactID, digest string, parts int, expiresAt time.Time) (*upload.U, error)
+	Upload(ctx context.Context, projectID, uploadID uuid.UUID) (*upload.U, error)
+	UploadPart(ctx context.Context, projectID, uploadID uuid.UUID, number int, src io.Reader, size int64) error
+	UploadComplete(ctx context.Context, projectID, uploadID uuid.UUID, maxSize int64) (*artifact.A, error)
+	UploadAbort(ctx context.Context, projectID, uploadID uuid.UUID) error
+	UploadsExpire(ctx context.Context, t time.Time) (int, error)
+
+	Reconcile(ctx context.Context, modifiedBefore time.Time, apply bool) (*gc.Report, error)
+
+	RetentionPolicy(ctx context.Context, projectID uuid.UUID) (*retention.Policy, error)
+	RetentionPolicySet(ctx context.Context, p *retention.Policy) error
+	RetentionPolicies(ctx context.Context) ([]*retention.Policy, error)
+	ArtifactTouch(ctx context.Context, projectID uuid.UUID, scope, artifactID string, t time.Time) error
+	ArtifactAccessedSet(ctx context.Context, projectID uuid.UUID, scope, artifactID string, t time.Time) error
+
+	Quota(ctx context.Context, projectID uuid.UUID) (*quota.Q, error)
+	QuotaSet(ctx context.Context, q *quota.Q) error
+	ProjectUsage(ctx context.Context, projectID uuid.UUID) (quota.Usage, error)
+	ProjectsUsage(ctx context.Context) (map[string]quota.Usage, error)
+
+	ReplicationMark(ctx context.Context, target string, projectID uuid.UUID) (time.Time, error)
+	ReplicationMarkSet(ctx context.Context, target string, projectID uuid.UUID, mark time.Time) error
+	ArtifactsSince(ctx context.Context, projectID uuid.UUID, t time.Time) ([]*artifact.A, error)
+
+	Checks() []health.Check
+}
+
+// Upstream is another bobc server artifacts missing locally
+// are fetched from and uploads are forwarded to.
+type Upstream interface {
+	Artifact(ctx context.Context, projectPath, scope, artifactID string) (*artifact.A, io.ReadCloser, error)
+	ArtifactsExist(ctx context.Context, projectPath, scope string, artifactIDs []string) (map[string]string, error)
+	ArtifactCreate(ctx context.Context, projectPath, scope, artifactID, digest string, src io.Reader) error
+}
+
+// ReplicationTarget is another bobc server projects are replicated to.
+type ReplicationTarget interface {
+	Address() string
+	OrganizationCreate(ctx context.Context, name, description string) error
+	ProjectCreate(ctx context.Context, projectPath, description string, public bool) error
+	ArtifactsExist(ctx context.Context, projectPath, scope string, artifactIDs []string) (map[string]string, error)
+	ArtifactCreate(ctx context.Context, projectPath, scope, artifactID, digest string, src io.Reader) error
+}
+
+type TokenRepository interface {
+	TokenCreate(t *token.T, hash string) error
+	Tokens() ([]*token.T, error)
+	TokenByHash(hash string) (*token.T, error)
+	TokenDelete(id uuid.UUID) error
+}
 
-	Project(id uuid.UUID) (*project.P, error)
-	ProjectByName(name string) (*project.P, error)
-	Projects() ([]*project.P, error)
-	ProjectDelete(id uuid.UUID) error
+type OrganizationRepository interface {
+	UserCreate(u *user.U) error
+	Users() ([]*user.U, error)
+	User(id uuid.UUID) (*user.U, error)
+	UserByName(name string) (*user.U, error)
+	UserDelete(id uuid.UUID) error
 
-	CreateArtifact(projectID uuid.UUID, artifactID string, filePath string, size int) error
-	ProjectArtifact(projectID uuid.UUID, artifactID string) (*artifact.A, error)
-	ProjectArtifactDelete(projectID uuid.UUID, artifactID string) error
+	OrganizationCreate(o *organization.O) error
+	Organizations() ([]*organization.O, error)
+	OrganizationByName(name string) (*organization.O, error)
+	OrganizationDelete(id uuid.UUID) error
 
-	ProjectArtifactExists(projectID uuid.UUID, artifactID string) (bool, error)
+	MemberSet(m *organization.Member) error
+	MemberRemove(organizationID, userID uuid.UUID) error
+	Members(organizationID uuid.UUID) ([]*organization.Member, error)
+	Memberships(userID uuid.UUID) ([]*organization.Member, error)
 }
@@ -1,5 +1,7 @@
 package application
 
+import "time"
+
 type Option func(*application)
 
 func WithProjectRepository(repo ProjectRepository) Option {
@@ -7,3 +9,55 @@ func WithProjectRepository(repo ProjectRepository) Option {
 		app.projects = repo
 	}
 }
+
+func WithTokenRepository(repo TokenRepository) Option {
+	return func(app *application) {
+		app.tokens = repo
+	}
+}
+
+func WithOrganizationRepository(repo OrganizationRepository) Option {
+	return func(app *application) {
+		app.orgs = repo
+	}
+}
+
+func WithUploadExpiry(d time.Duration) Option {
+	return func(app *application) {
+		app.uploadExpiry = d
+	}
+}
+
+func WithGCGracePeriod(d time.Duration) Option {
+	return func(app *application) {
+		app.gcGracePeriod = d
+	}
+}
+
+func WithScopeFallback(scopes []string) Option {
+	return func(app *application) {
+		app.scopeFallback = scopes
+	}
+}
+
+// WithUpstream makes the server fetch artifacts missing locally from u.
+func WithUpstream(u Upstream) Option {
+	return func(app *application) {
+		app.upstream = u
+	}
+}
+
+// WithUpstreamTimeout limits the time fetching an artifact
+// from or forwarding one to the upstream may take.
+func WithUpstreamTimeout(timeout time.Duration) Option {
+	return func(app *application) {
+		app.upstreamTimeout = timeout
+	}
+}
+
+// WithUpstreamForward makes the server forward uploads to the upstream.
+func WithUpstreamForward(forward bool) Option {
+	return func(app *application) {
+		app.upstreamForward = forward
+	}
+}
@@ -0,0 +1,237 @@
+package application
+
+import (
+	"context"
+	"errors"
+
+	"github.com/benchkram/bobc/pkg/logging"
+	"github.com/benchkram/bobc/pkg/organization"
+	"github.com/benchkram/bobc/pkg/orgrepo"
+	"github.com/benchkram/bobc/pkg/principal"
+	"github.com/benchkram/bobc/pkg/token"
+	"github.com/benchkram/bobc/pkg/tracing"
+	"github.com/benchkram/errz"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+func (s *application) OrganizationCreate(ctx context.Context, name, description string) (_ *organization.O, err error) {
+	ctx, span := tracing.Start(ctx, "application.OrganizationCreate")
+	defer tracing.End(span, &err)
+	defer errz.Recover(&err)
+
+	err = s.authorize(ctx, uuid.Nil, token.ScopeAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	if !accountName.MatchString(name) {
+		return nil, ErrInvalidOrganizationName
+	}
+
+	o := organization.New(name, description)
+	err = s.orgs.OrganizationCreate(o)
+	if errors.Is(err, orgrepo.ErrAlreadyExists) {
+		return nil, ErrOrganizationAlreadyExists
+	}
+	errz.Fatal(err)
+
+	log.Ctx(ctx).Info().
+		Str("organization", o.Name).
+		Str(logging.FieldSubject, subject(ctx)).
+		Msg("Organization created.")
+
+	return o, nil
+}
+
+// Organizations returns the organizations the caller is allowed to read.
+func (s *application) Organizations(ctx context.Context) (_ []*organization.O, err error) {
+	ctx, span := tracing.Start(ctx, "application.Organizations")
+	defer tracing.End(span, &err)
+	defer errz.Recover(&err)
+
+	p := principal.FromContext(ctx)
+	if p == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	all, err := s.orgs.Organizations()
+	errz.Fatal(err)
+
+	orgs := []*organization.O{}
+	for _, o := range all {
+		if p.AllowsIn(o.ID, uuid.Nil, token.ScopeRead) {
+			orgs = append(orgs, o)
+		}
+	}
+
+	return orgs, nil
+}
+
+func (s *application) OrganizationByName(ctx context.Context, name string) (_ *organization.O, err error) {
+	ctx, span := tracing.Start(ctx, "application.OrganizationByName")
+	defer tracing.End(span, &err)
+	defer errz.Recover(&err)
+
+	return s.authorizedOrganization(ctx, name, token.ScopeRead)
+}
+
+// OrganizationDelete deletes an organization along with its members.
+// Organizations still owning projects are not deleted.
+func (s *application) OrganizationDelete(ctx context.Context, name string) (err error) {
+	ctx, span := tracing.Start(ctx, "application.OrganizationDelete")
+	defer tracing.End(span, &err)
+	defer errz.Recover(&err)
+
+	err = s.authorize(ctx, uuid.Nil, token.ScopeAdmin)
+	if err != nil {
+		return err
+	}
+
+	o, err := s.organization(name)
+	if err != nil {
+		return err
+	}
+
+	err = s.orgs.OrganizationDelete(o.ID)
+	if errors.Is(err, orgrepo.ErrNotEmpty) {
+		return ErrOrganizationNotEmpty
+	} else if errors.Is(err, orgrepo.ErrNotFound) {
+		return ErrOrganizationNotFound
+	}
+	errz.Fatal(err)
+
+	log.Ctx(ctx).Info().
+		Str("organization", o.Name).
+		Str(logging.FieldSubject, subject(ctx)).
+		Msg("Organization deleted.")
+
+	return nil
+}
+
+func (s *application) Members(ctx context.Context, orgName string) (_ []*organization.Member, err error) {
+	ctx, span := tracing.Start(ctx, "application.Members")
+	defer tracing.End(span, &err)
+	defer errz.Recover(&err)
+
+	o, err := s.OrganizationByName(ctx, orgName)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.orgs.Members(o.ID)
+}
+
+// MemberSet gives a user a role in an organization,
+// the user becomes a member if it isn't already.
+func (s *application) MemberSet(ctx context.Context, orgName, userName string, role organization.Role) (_ *organization.Member, err error) {
+	ctx, span := tracing.Start(ctx, "application.MemberSet")
+	defer tracing.End(span, &err)
+	defer errz.Recover(&err)
+
+	if !role.Valid() {
+		return nil, ErrInvalidRole
+	}
+
+	o, err := s.authorizedOrganization(ctx, orgName, token.ScopeAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := s.user(userName)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &organization.Member{
+		OrganizationID: o.ID,
+		UserID:         u.ID,
+		UserName:       u.Name,
+		Role:           role,
+	}
+	err = s.orgs.MemberSet(m)
+	errz.Fatal(err)
+
+	log.Ctx(ctx).Info().
+		Str("user", u.Name).
+		Str("role", string(role)).
+		Str("organization", o.Name).
+		Str(logging.FieldSubject, subject(ctx)).
+		Msg("Organization role granted.")
+
+	return m, nil
+}
+
+func (s *application) MemberRemove(ctx context.Context, orgName, userName string) (err error) {
+	ctx, span := tracing.Start(ctx, "application.MemberRemove")
+	defer tracing.End(span, &err)
+	defer errz.Recover(&err)
+
+	o, err := s.authorizedOrganization(ctx, orgName, token.ScopeAdmin)
+	if err != nil {
+		return err
+	}
+
+	u, err := s.user(userName)
+	if errors.Is(err, ErrUserNotFound) {
+		return ErrMemberNotFound
+	} else if err != nil {
+		return err
+	}
+
+	err = s.orgs.MemberRemove(o.ID, u.ID)
+	if errors.Is(err, orgrepo.ErrNotFound) {
+		return ErrMemberNotFound
+	}
+	errz.Fatal(err)
+
+	log.Ctx(ctx).Info().
+		Str("user", u.Name).
+		Str("organization", o.Name).
+		Str(logging.FieldSubject, subject(ctx)).
+		Msg("User removed from organization.")
+
+	return nil
+}
+
+// authorizedOrganization looks up an organization by name for a caller
+// allowed scope in it. Callers without credentials are turned away before
+// the lookup, callers not allowed to read the organization can't tell
+// it from a missing one.
+func (s *application) authorizedOrganization(ctx context.Context, name string, scope token.Scope) (_ *organization.O, err error) {
+	defer errz.Recover(&err)
+
+	p := principal.FromContext(ctx)
+	if p == nil || p.Anonymous {
+		return nil, ErrUnauthenticated
+	}
+
+	o, err := s.organization(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.AllowsIn(o.ID, uuid.Nil, token.ScopeRead) {
+		return nil, ErrOrganizationNotFound
+	}
+
+	err = authorizeOrganization(ctx, o.ID, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	return o, nil
+}
+
+// organization looks up an organization by name without authorization.
+func (s *application) organization(name string) (_ *organization.O, err error) {
+	defer errz.Recover(&err)
+
+	o, err := s.orgs.OrganizationByName(name)
+	if errors.Is(err, orgrepo.ErrNotFound) {
+		return nil, ErrOrganizationNotFound
+	}
+	errz.Fatal(err)
+
+	return o, nil
+}
@@ -1,31 +1,61 @@
 package application
 
 import (
+	"context"
 	"errors"
 	"regexp"
+	"strings"
 
+	"github.com/benchkram/bobc/pkg/logging"
+	"github.com/benchkram/bobc/pkg/organization"
+	"github.com/benchkram/bobc/pkg/principal"
 	"github.com/benchkram/bobc/pkg/project"
 	"github.com/benchkram/bobc/pkg/projectrepo"
+	"github.com/benchkram/bobc/pkg/token"
+	"github.com/benchkram/bobc/pkg/tracing"
 	"github.com/benchkram/errz"
 	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
 )
 
-func (s *application) ProjectCreate(name, description string) (_ *project.P, err error) {
+// ProjectCreate creates a project owned by an organization. The name can
+// be a project path `organization/project` instead of passing the organization.
+// Projects created without an organization belong to the default organization.
+func (s *application) ProjectCreate(ctx context.Context, orgName, name, description string) (_ *project.P, err error) {
+	ctx, span := tracing.Start(ctx, "application.ProjectCreate")
+	defer tracing.End(span, &err)
 	defer errz.Recover(&err)
 
+	if strings.Contains(name, project.Separator) {
+		var pathOrg string
+		pathOrg, name = project.SplitPath(name)
+		if pathOrg == "" || (orgName != "" && !strings.EqualFold(orgName, pathOrg)) {
+			return nil, ErrInvalidProjectName
+		}
+		orgName = pathOrg
+	}
+
+	if orgName == "" {
+		orgName = organization.DefaultName
+	}
+
+	org, err := s.authorizedOrganization(ctx, orgName, token.ScopeAdmin)
+	if err != nil {
+		return nil, err
+	}
+
 	if !s.projectNameValid(name) {
 		return nil, ErrInvalidProjectName
 	}
 
-	//_, err = s.projects.ProjectByName(name)
-	//if err == nil {
-	//	return nil, ErrProjectAlreadyExists
-	//} else if !errors.Is(err, projectrepo.ErrNotFound) {
-	//	errz.Fatal(err)
-	//}
+	p := project.New(org.ID, name, description)
+	p.Organization = org.Name
 
-	p := project.New(name, description)
-	err = s.projects.CreateOrUpdate(p)
+	// paths are compared ignoring case
+	err = s.projects.CreateOrUpdate(ctx, p)
+	if errors.Is(err, projectrepo.ErrAlreadyExists) {
+		return nil, ErrProjectAlreadyExists
+	}
 	errz.Fatal(err)
 
 	return p, nil
@@ -44,10 +74,17 @@ func (s *application) projectNameValid(name string) bool {
 	return rex.MatchString(name) && name != "." && name != ".."
 }
 
-func (s *application) Project(id uuid.UUID) (_ *project.P, err error) {
+func (s *application) Project(ctx context.Context, id uuid.UUID) (_ *project.P, err error) {
+	ctx, span := tracing.Start(ctx, "application.Project")
+	defer tracing.End(span, &err)
 	defer errz.Recover(&err)
 
-	p, err := s.projects.Project(id)
+	err = s.authorize(ctx, id, token.ScopeRead)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := s.projects.Project(ctx, id)
 	if errors.Is(err, projectrepo.ErrNotFound) {
 		return nil, ErrProjectNotFound
 	} else if err != nil {
@@ -57,51 +94,167 @@ func (s *application) Project(id uuid.UUID) (_ *project.P, err error) {
 	return p, nil
 }
 
-func (s *application) Projects() (_ []*project.P, err error) {
+// Projects returns the projects the caller is allowed to read,
+// including all public projects.
+func (s *application) Projects(ctx context.Context) (_ []*project.P, err error) {
+	ctx, span := tracing.Start(ctx, "application.Projects")
+	defer tracing.End(span, &err)
 	defer errz.Recover(&err)
 
-	return s.projects.Projects()
+	p := principal.FromContext(ctx)
+	if p == nil {
+		return nil, ErrUnauthenticated
+	}
+
+	all, err := s.projects.Projects(ctx)
+	errz.Fatal(err)
+
+	projects := []*project.P{}
+	for _, pr := range all {
+		if pr.Public || p.AllowsIn(pr.OrganizationID, pr.ID, token.ScopeRead) {
+			projects = append(projects, pr)
+		}
+	}
+
+	return projects, nil
 }
 
-func (s *application) ProjectByName(name string) (_ *project.P, err error) {
+func (s *application) ProjectByName(ctx context.Context, name string) (_ *project.P, err error) {
+	ctx, span := tracing.Start(ctx, "application.ProjectByName")
+	defer tracing.End(span, &err)
 	defer errz.Recover(&err)
 
-	p, err := s.projects.ProjectByName(name)
+	p, err := s.projects.ProjectByName(ctx, name)
 	if errors.Is(err, projectrepo.ErrNotFound) {
-		return nil, ErrProjectNotFound
+		return nil, projectNotFound(ctx)
 	} else if err != nil {
 		return nil, err
 	}
 
+	err = s.authorize(ctx, p.ID, token.ScopeRead)
+	if err != nil {
+		return nil, hideProject(err)
+	}
+
 	return p, nil
 }
 
-func (s *application) ProjectExists(name string) (exists bool, err error) {
+// ProjectIDByName resolves the id of a project
+// without loading its artifacts.
+func (s *application) ProjectIDByName(ctx context.Context, name string) (_ uuid.UUID, err error) {
+	ctx, span := tracing.Start(ctx, "application.ProjectIDByName")
+	defer tracing.End(span, &err)
 	defer errz.Recover(&err)
 
-	_, err = s.projects.ProjectByName(name)
-	if err == nil {
-		exists = true
+	id, err := s.projects.ProjectIDByName(ctx, name)
+	if errors.Is(err, projectrepo.ErrNotFound) {
+		return uuid.Nil, projectNotFound(ctx)
+	}
+	errz.Fatal(err)
+
+	err = s.authorize(ctx, id, token.ScopeRead)
+	if err != nil {
+		return uuid.Nil, hideProject(err)
+	}
+
+	return id, nil
+}
+
+// projectNotFound is returned for projects looked up by name which don't
+// exist. Anonymous callers are asked for credentials, like they are for
+// private projects, so project names can't be probed.
+func projectNotFound(ctx context.Context) error {
+	p := principal.FromContext(ctx)
+	if p == nil || p.Anonymous {
+		return ErrUnauthenticated
+	}
+	return ErrProjectNotFound
+}
+
+// hideProject turns a denied read of a project looked up by name
+// into ErrProjectNotFound, so it can't be told from a missing one.
+func hideProject(err error) error {
+	if errors.Is(err, ErrForbidden) {
+		return ErrProjectNotFound
+	}
+	return err
+}
+
+// ProjectExists reports if a project exists. Callers restricted to
+// other projects are only told about the projects they can read.
+func (s *application) ProjectExists(ctx context.Context, name string) (exists bool, err error) {
+	ctx, span := tracing.Start(ctx, "application.ProjectExists")
+	defer tracing.End(span, &err)
+	defer errz.Recover(&err)
+
+	id, err := s.projects.ProjectIDByName(ctx, name)
+	if errors.Is(err, projectrepo.ErrNotFound) {
+		err = projectNotFound(ctx)
 	} else {
-		if errors.Is(err, projectrepo.ErrNotFound) {
-			exists = false
-		} else {
-			errz.Fatal(err)
-		}
+		errz.Fatal(err)
+		err = hideProject(s.authorize(ctx, id, token.ScopeRead))
 	}
 
-	return exists, nil
+	if errors.Is(err, ErrProjectNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, nil
 }
 
-func (s *application) ProjectDelete(projectID uuid.UUID) (err error) {
+func (s *application) ProjectDelete(ctx context.Context, projectID uuid.UUID) (err error) {
+	ctx, span := tracing.Start(ctx, "application.ProjectDelete")
+	defer tracing.End(span, &err)
 	defer errz.Recover(&err)
 
-	_, err = s.projects.Project(projectID)
+	err = s.authorize(ctx, projectID, token.ScopeAdmin)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.projects.Project(ctx, projectID)
 	errz.Fatal(err)
 
 	// Delete from database
-	err = s.projects.ProjectDelete(projectID)
+	err = s.projects.ProjectDelete(ctx, projectID)
 	errz.Fatal(err)
 
+	log.Ctx(ctx).Info().
+		Str(logging.FieldProjectID, projectID.String()).
+		Str(logging.FieldSubject, subject(ctx)).
+		Msg("Project deleted.")
+
+	return nil
+}
+
+// ProjectVisibilitySet makes a project public or private.
+func (s *application) ProjectVisibilitySet(ctx context.Context, projectID uuid.UUID, public bool) (err error) {
+	ctx, span := tracing.Start(ctx, "application.ProjectVisibilitySet")
+	defer tracing.End(span, &err)
+	defer errz.Recover(&err)
+
+	err = s.authorize(ctx, projectID, token.ScopeAdmin)
+	if err != nil {
+		return err
+	}
+
+	err = s.projects.ProjectVisibilitySet(ctx, projectID, public)
+	if errors.Is(err, projectrepo.ErrNotFound) {
+		return ErrProjectNotFound
+	}
+	errz.Fatal(err)
+
+	visibility := "private"
+	if public {
+		visibility = "public"
+	}
+	log.Ctx(ctx).Info().
+		Str(logging.FieldProjectID, projectID.String()).
+		Str("visibility", visibility).
+		Str(logging.FieldSubject, subject(ctx)).
+		Msg("Project visibility changed.")
+
 	return nil
 }
@@ -0,0 +1,86 @@
+package application
+
+import (
+	"context"
+
+	"github.com/benchkram/bobc/pkg/quota"
+	"github.com/benchkram/bobc/pkg/token"
+	"github.com/benchkram/bobc/pkg/tracing"
+	"github.com/benchkram/errz"
+	"github.com/google/uuid"
+)
+
+func (s *application) Quota(ctx context.Context, projectID uuid.UUID) (_ *quota.Q, err error) {
+	ctx, span := tracing.Start(ctx, "application.Quota")
+	defer tracing.End(span, &err)
+	defer errz.Recover(&err)
+
+	err = s.authorize(ctx, projectID, token.ScopeRead)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.projects.Quota(ctx, projectID)
+}
+
+func (s *application) QuotaSet(ctx context.Context, q *quota.Q) (err error) {
+	ctx, span := tracing.Start(ctx, "application.QuotaSet")
+	defer tracing.End(span, &err)
+	defer errz.Recover(&err)
+
+	err = s.authorize(ctx, q.ProjectID, token.ScopeAdmin)
+	if err != nil {
+		return err
+	}
+
+	if q.MaxBytes < 0 || q.MaxArtifacts < 0 {
+		return ErrInvalidQuota
+	}
+
+	return s.projects.QuotaSet(ctx, q)
+}
+
+func (s *application) ProjectUsage(ctx context.Context, projectID uuid.UUID) (_ quota.Usage, err error) {
+	ctx, span := tracing.Start(ctx, "application.ProjectUsage")
+	defer tracing.End(span, &err)
+	defer errz.Recover(&err)
+
+	err = s.authorize(ctx, projectID, token.ScopeRead)
+	if err != nil {
+		return quota.Usage{}, err
+	}
+
+	return s.projects.ProjectUsage(ctx, projectID)
+}
+
+// ProjectsUsage returns the usage of all projects keyed by project path.
+// Not authorized, it's meant for internal use like metrics.
+func (s *application) ProjectsUsage() (map[string]quota.Usage, error) {
+	return s.projects.ProjectsUsage(context.Background())
+}
+
+// quotaRemaining checks if the quota of a project allows to store the given
+// number of additional artifacts and bytes. Returns the number of bytes
+// which can still be stored or quota.Unlimited.
+//
+// Uploads running in parallel are not accounted for, the repository
+// checks the quota again when an artifact is finally recorded.
+func (s *application) quotaRemaining(ctx context.Context, projectID uuid.UUID, bytes int64, artifacts int) (_ int64, err error) {
+	defer errz.Recover(&err)
+
+	q, err := s.projects.Quota(ctx, projectID)
+	errz.Fatal(err)
+
+	if q.MaxBytes == 0 && q.MaxArtifacts == 0 {
+		return quota.Unlimited, nil
+	}
+
+	u, err := s.projects.ProjectUsage(ctx, projectID)
+	errz.Fatal(err)
+
+	if !q.Allows(u, bytes, artifacts) {
+		return 0, ErrQuotaExceeded
+	}
+
+	return q.Remaining(u), nil
+}
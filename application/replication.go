@@ -0,0 +1,198 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/benchkram/bobc/pkg/artifact"
+	"github.com/benchkram/bobc/pkg/logging"
+	"github.com/benchkram/bobc/pkg/project"
+	"github.com/benchkram/bobc/pkg/projectrepo"
+	"github.com/benchkram/bobc/pkg/replication"
+	"github.com/benchkram/bobc/pkg/tracing"
+	"github.com/benchkram/bobc/pkg/upstream"
+	"github.com/benchkram/errz"
+	"github.com/rs/zerolog/log"
+)
+
+// replicationOverlap is subtracted from the high-water mark of a project
+// to catch artifacts recorded with a slightly older creation time while
+// the last run was in progress. They are compared with the target again.
+const replicationOverlap = time.Minute
+
+// Replicate copies the artifacts of the projects at projectPaths, or all
+// projects if empty, missing on target. Only artifacts created since the
+// last run to target are compared with the artifacts of the target,
+// artifacts deleted locally are kept on the target.
+func (s *application) Replicate(target ReplicationTarget, projectPaths []string) (_ *replication.Report, err error) {
+	ctx, span := tracing.Start(context.Background(), "application.Replicate")
+	defer tracing.End(span, &err)
+	defer errz.Recover(&err)
+
+	var projects []*project.P
+	if len(projectPaths) == 0 {
+		projects, err = s.projects.Projects(ctx)
+		errz.Fatal(err)
+	} else {
+		for _, path := range projectPaths {
+			p, err := s.projects.ProjectByName(ctx, path)
+			if errors.Is(err, projectrepo.ErrNotFound) {
+				return nil, ErrProjectNotFound
+			}
+			errz.Fatal(err)
+			projects = append(projects, p)
+		}
+	}
+
+	report := &replication.Report{Target: target.Address()}
+	for _, p := range projects {
+		r, err := s.replicateProject(ctx, target, p)
+		errz.Fatal(err)
+		report.Projects = append(report.Projects, r)
+	}
+
+	if report.Transferred() > 0 || report.Failed() > 0 {
+		log.Info().Str("summary", report.Summary()).Msg("Replication finished.")
+	}
+
+	return report, nil
+}
+
+// replicateProject copies the artifacts of a project missing on target and
+// advances the high-water mark up to the first artifact which failed to copy.
+// Projects missing on target are created along with their organization.
+func (s *application) replicateProject(ctx context.Context, target ReplicationTarget, p *project.P) (_ *replication.Project, err error) {
+	defer errz.Recover(&err)
+
+	r := &replication.Project{Path: p.Path()}
+
+	mark, err := s.projects.ReplicationMark(ctx, target.Address(), p.ID)
+	errz.Fatal(err)
+
+	since := mark
+	if !since.IsZero() {
+		since = since.Add(-replicationOverlap)
+	}
+
+	artifacts, err := s.projects.ArtifactsSince(ctx, p.ID, since)
+	errz.Fatal(err)
+
+	if len(artifacts) == 0 {
+		return r, nil
+	}
+	r.Checked = len(artifacts)
+
+	present, err := s.replicatedArtifacts(ctx, target, p, artifacts)
+	if errors.Is(err, upstream.ErrNotFound) {
+		present, err = map[string]bool{}, s.replicateProjectCreate(ctx, target, p)
+	}
+	if err != nil {
+		r.Err = err
+		return r, nil
+	}
+
+	newMark := mark
+	for _, a := range artifacts {
+		if !present[replicationKey(a.Scope, a.ID)] {
+			err = s.replicateArtifact(ctx, target, p, a)
+			if err != nil {
+				log.Ctx(ctx).Error().Err(err).
+					Str(logging.FieldProject, p.Path()).
+					Str(logging.FieldArtifactID, a.ID).
+					Str("target", target.Address()).
+					Msg("Replicating artifact failed.")
+				r.Failed++
+				continue
+			}
+			present[replicationKey(a.Scope, a.ID)] = true
+			r.Transferred++
+		}
+
+		if r.Failed == 0 && a.CreatedAt.After(newMark) {
+			newMark = a.CreatedAt
+		}
+	}
+
+	if newMark.After(mark) {
+		err = s.projects.ReplicationMarkSet(ctx, target.Address(), p.ID, newMark)
+		errz.Fatal(err)
+	}
+
+	return r, nil
+}
+
+// replicatedArtifacts looks up which of artifacts exist on target in their
+// scope, keyed by replicationKey. Artifacts the target finds in one of its
+// fallback scopes are not in their scope and are missing. Returns
+// upstream.ErrNotFound if the project is missing on target.
+func (s *application) replicatedArtifacts(ctx context.Context, target ReplicationTarget, p *project.P, artifacts []*artifact.A) (map[string]bool, error) {
+	scopes := []string{}
+	ids := map[string][]string{}
+	for _, a := range artifacts {
+		if _, ok := ids[a.Scope]; !ok {
+			scopes = append(scopes, a.Scope)
+		}
+		ids[a.Scope] = append(ids[a.Scope], a.ID)
+	}
+
+	present := make(map[string]bool, len(artifacts))
+	for _, sc := range scopes {
+		for start := 0; start < len(ids[sc]); start += maxArtifactsExist {
+			end := start + maxArtifactsExist
+			if end > len(ids[sc]) {
+				end = len(ids[sc])
+			}
+
+			found, err := target.ArtifactsExist(ctx, p.Path(), sc, ids[sc][start:end])
+			if err != nil {
+				return nil, err
+			}
+
+			for id, foundIn := range found {
+				if foundIn == sc {
+					present[replicationKey(sc, id)] = true
+				}
+			}
+		}
+	}
+
+	return present, nil
+}
+
+// replicationKey identifies an artifact by its scope and id.
+func replicationKey(scope, artifactID string) string {
+	return scope + "/" + artifactID
+}
+
+// replicateProjectCreate creates a project on target with the description and
+// visibility it has locally, creating its organization first if missing.
+func (s *application) replicateProjectCreate(ctx context.Context, target ReplicationTarget, p *project.P) (err error) {
+	defer errz.Recover(&err)
+
+	o, err := s.organization(p.Organization)
+	errz.Fatal(err)
+
+	err = target.OrganizationCreate(ctx, o.Name, o.Description)
+	errz.Fatal(err)
+
+	err = target.ProjectCreate(ctx, p.Path(), p.Description, p.Public)
+	errz.Fatal(err)
+
+	log.Ctx(ctx).Info().
+		Str(logging.FieldProject, p.Path()).
+		Str("target", target.Address()).
+		Msg("Project created on replication target.")
+
+	return nil
+}
+
+func (s *application) replicateArtifact(ctx context.Context, target ReplicationTarget, p *project.P, a *artifact.A) (err error) {
+	defer errz.Recover(&err)
+
+	src, err := s.projects.ArtifactRead(ctx, p.ID, a.Scope, a.ID)
+	errz.Fatal(err)
+	defer src.Close()
+
+	return target.ArtifactCreate(ctx, p.Path(), a.Scope, a.ID, a.Digest, src)
+}
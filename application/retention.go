@@ -0,0 +1,98 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/benchkram/bobc/pkg/logging"
+	"github.com/benchkram/bobc/pkg/principal"
+	"github.com/benchkram/bobc/pkg/projectrepo"
+	"github.com/benchkram/bobc/pkg/retention"
+	"github.com/benchkram/bobc/pkg/scope"
+	"github.com/benchkram/bobc/pkg/token"
+	"github.com/benchkram/bobc/pkg/tracing"
+	"github.com/benchkram/errz"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+func (s *application) RetentionPolicy(ctx context.Context, projectID uuid.UUID) (_ *retention.Policy, err error) {
+	ctx, span := tracing.Start(ctx, "application.RetentionPolicy")
+	defer tracing.End(span, &err)
+	defer errz.Recover(&err)
+
+	err = s.authorize(ctx, projectID, token.ScopeRead)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.projects.RetentionPolicy(ctx, projectID)
+}
+
+func (s *application) RetentionPolicySet(ctx context.Context, p *retention.Policy) (err error) {
+	ctx, span := tracing.Start(ctx, "application.RetentionPolicySet")
+	defer tracing.End(span, &err)
+	defer errz.Recover(&err)
+
+	err = s.authorize(ctx, p.ProjectID, token.ScopeAdmin)
+	if err != nil {
+		return err
+	}
+
+	if p.MaxAge < 0 || p.MaxBytes < 0 || p.KeepLast < 0 {
+		return ErrInvalidRetentionPolicy
+	}
+
+	return s.projects.RetentionPolicySet(ctx, p)
+}
+
+// RetentionEnforce evicts the artifacts violating the retention policy of their project.
+// Failures are logged and don't stop the eviction of the remaining artifacts,
+// artifacts deleted in the meantime count as evicted.
+func (s *application) RetentionEnforce() (err error) {
+	ctx, span := tracing.Start(context.Background(), "application.RetentionEnforce")
+	defer tracing.End(span, &err)
+	defer errz.Recover(&err)
+
+	ctx = principal.NewContext(ctx, principal.Admin("retention"))
+
+	policies, err := s.projects.RetentionPolicies(ctx)
+	errz.Fatal(err)
+
+	now := time.Now()
+	for _, p := range policies {
+		project, err := s.projects.Project(ctx, p.ProjectID)
+		if err != nil {
+			if !errors.Is(err, projectrepo.ErrNotFound) {
+				log.Ctx(ctx).Error().Err(err).
+					Str(logging.FieldProjectID, p.ProjectID.String()).
+					Msg("Enforcing retention policy failed.")
+			}
+			continue
+		}
+
+		evicted := 0
+		for _, a := range p.Evict(project.Artifacts, now) {
+			err = s.ProjectArtifactDelete(scope.NewContext(ctx, a.Scope), p.ProjectID, a.ID)
+			if err != nil && !errors.Is(err, projectrepo.ErrNotFound) && !errors.Is(err, ErrProjectNotFound) {
+				log.Ctx(ctx).Error().Err(err).
+					Str(logging.FieldProjectID, project.ID.String()).
+					Str(logging.FieldArtifactID, a.ID).
+					Str(logging.FieldScope, a.Scope).
+					Msg("Evicting artifact failed.")
+				continue
+			}
+			evicted++
+		}
+
+		if evicted > 0 {
+			log.Ctx(ctx).Info().
+				Str(logging.FieldProjectID, project.ID.String()).
+				Int("artifacts", evicted).
+				Msg("Artifacts evicted.")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/benchkram/bobc/application"
+	"github.com/benchkram/bobc/pkg/projectrepo"
+	"github.com/benchkram/bobc/pkg/rnd"
+	"github.com/benchkram/bobc/pkg/scope"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProjectExportImport(t *testing.T) {
+	app, err := setup()
+	assert.Nil(t, err)
+
+	project, err := app.ProjectCreate(adminCtx, "", rnd.RandStringBytesMaskImprSrc(8), "a test project")
+	assert.Nil(t, err)
+
+	id := rnd.RandSHA1(8)
+	_, err = app.ProjectArtifactCreate(adminCtx, project.ID, id, "", bytes.NewReader(make([]byte, 10)))
+	assert.Nil(t, err)
+
+	featureCtx := scope.NewContext(adminCtx, "feature")
+	_, err = app.ProjectArtifactCreate(featureCtx, project.ID, id, "", bytes.NewReader(make([]byte, 20)))
+	assert.Nil(t, err)
+
+	accessed := time.Now().Add(-48 * time.Hour).UTC()
+	repo := projectrepo.New(newDatabase(), newArtifactStore())
+	err = repo.ArtifactAccessedSet(adminCtx, project.ID, "feature", id, accessed)
+	assert.Nil(t, err)
+
+	buf := &bytes.Buffer{}
+	err = app.ProjectExport(adminCtx, project.ID, buf)
+	assert.Nil(t, err)
+	archive := buf.Bytes()
+
+	// importing into the same project skips existing artifacts
+	report, err := app.ProjectImport(adminCtx, bytes.NewReader(archive), "")
+	assert.Nil(t, err)
+	assert.Equal(t, project.Path(), report.Project)
+	assert.Equal(t, 0, report.Imported)
+	assert.Equal(t, 2, report.Skipped)
+
+	// the project and its organization are created if missing
+	path := rnd.RandStringBytesMaskImprSrc(8) + "/" + rnd.RandStringBytesMaskImprSrc(8)
+	report, err = app.ProjectImport(adminCtx, bytes.NewReader(archive), path)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, report.Imported)
+
+	imported, err := app.ProjectByName(adminCtx, path)
+	assert.Nil(t, err)
+	assert.Equal(t, "a test project", imported.Description)
+
+	a, err := app.ProjectArtifact(featureCtx, imported.ID, id)
+	assert.Nil(t, err)
+	assert.Equal(t, 20, a.Size)
+	assert.Equal(t, "feature", a.Scope)
+	// the last access is restored for retention policies
+	assert.WithinDuration(t, accessed, a.LastAccessedAt, time.Second)
+
+	_, err = app.ProjectImport(adminCtx, bytes.NewReader([]byte("no archive")), "")
+	assert.ErrorIs(t, err, application.ErrInvalidArchive)
+}
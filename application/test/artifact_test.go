@@ -1,14 +1,16 @@
 package test
 
 import (
+	"bytes"
 	"io/ioutil"
 	"net/http"
-	"os"
-	"path/filepath"
 	"strings"
 	"testing"
 
+	"github.com/benchkram/bobc/application"
+	"github.com/benchkram/bobc/pkg/quota"
 	"github.com/benchkram/bobc/pkg/rnd"
+	"github.com/benchkram/bobc/pkg/scope"
 	"github.com/benchkram/errz"
 	"github.com/stretchr/testify/assert"
 )
@@ -19,21 +21,16 @@ func TestArtifactCreation(t *testing.T) {
 
 	projectName := rnd.RandStringBytesMaskImprSrc(8)
 
-	project, err := app.ProjectCreate(projectName, "a test project")
+	project, err := app.ProjectCreate(adminCtx, "", projectName, "a test project")
 	assert.Nil(t, err)
 
-	filepath := filepath.Join(os.TempDir(), "file.test")
-	bigBuff := make([]byte, 750)
-	err = ioutil.WriteFile(filepath, bigBuff, 0666)
-	assert.Nil(t, err)
-	defer os.RemoveAll(filepath)
-
 	sha1Hash := rnd.RandSHA1(8)
-	err = app.ProjectArtifactCreate(project.ID, sha1Hash, filepath, 750)
+	a, err := app.ProjectArtifactCreate(adminCtx, project.ID, sha1Hash, "", bytes.NewReader(make([]byte, 750)))
 	errz.Log(err)
 	assert.Nil(t, err)
+	assert.Equal(t, 750, a.Size)
 
-	artifact, err := app.ProjectArtifact(project.ID, sha1Hash)
+	artifact, err := app.ProjectArtifact(adminCtx, project.ID, sha1Hash)
 	assert.Nil(t, err)
 
 	// get file from s3 storage through presigned link
@@ -50,20 +47,14 @@ func TestArtifactDeletion(t *testing.T) {
 
 	projectName := rnd.RandStringBytesMaskImprSrc(8)
 
-	project, err := app.ProjectCreate(projectName, "a test project")
-	assert.Nil(t, err)
-
-	filepath := filepath.Join(os.TempDir(), "file.test")
-	bigBuff := make([]byte, 750)
-	err = ioutil.WriteFile(filepath, bigBuff, 0666)
+	project, err := app.ProjectCreate(adminCtx, "", projectName, "a test project")
 	assert.Nil(t, err)
-	defer os.RemoveAll(filepath)
 
 	sha1Hash := rnd.RandSHA1(8)
-	err = app.ProjectArtifactCreate(project.ID, sha1Hash, filepath, 750)
+	_, err = app.ProjectArtifactCreate(adminCtx, project.ID, sha1Hash, "", bytes.NewReader(make([]byte, 750)))
 	assert.Nil(t, err)
 
-	artifact, err := app.ProjectArtifact(project.ID, sha1Hash)
+	artifact, err := app.ProjectArtifact(adminCtx, project.ID, sha1Hash)
 	assert.Nil(t, err)
 
 	// get file from s3 storage through presigned link
@@ -73,10 +64,10 @@ func TestArtifactDeletion(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, 750, len(body))
 
-	err = app.ProjectArtifactDelete(project.ID, sha1Hash)
+	err = app.ProjectArtifactDelete(adminCtx, project.ID, sha1Hash)
 	assert.Nil(t, err)
 
-	exists, err := app.ProjectArtifactExists(project.ID, sha1Hash)
+	exists, _, err := app.ProjectArtifactExists(adminCtx, project.ID, sha1Hash)
 	assert.Nil(t, err)
 	assert.False(t, exists)
 
@@ -87,3 +78,151 @@ func TestArtifactDeletion(t *testing.T) {
 	assert.Nil(t, err)
 	assert.True(t, strings.Contains(string(body), "NoSuchKey"))
 }
+
+func TestArtifactsExist(t *testing.T) {
+	app, err := setup()
+	assert.Nil(t, err)
+
+	projectName := rnd.RandStringBytesMaskImprSrc(8)
+
+	project, err := app.ProjectCreate(adminCtx, "", projectName, "a test project")
+	assert.Nil(t, err)
+
+	sha1Hash := rnd.RandSHA1(8)
+	_, err = app.ProjectArtifactCreate(adminCtx, project.ID, sha1Hash, "", bytes.NewReader(make([]byte, 750)))
+	assert.Nil(t, err)
+
+	missingHash := rnd.RandSHA1(8)
+	present, missing, _, err := app.ProjectArtifactsExist(adminCtx, project.ID, []string{missingHash, sha1Hash, missingHash})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{sha1Hash}, present)
+	assert.Equal(t, []string{missingHash}, missing)
+}
+
+func TestArtifactScopes(t *testing.T) {
+	app, err := setup()
+	assert.Nil(t, err)
+
+	project, err := app.ProjectCreate(adminCtx, "", rnd.RandStringBytesMaskImprSrc(8), "a test project")
+	assert.Nil(t, err)
+
+	mainCtx := scope.NewContext(adminCtx, "main")
+	featureCtx := scope.NewContext(adminCtx, "feature")
+
+	sha1Hash := rnd.RandSHA1(8)
+	_, err = app.ProjectArtifactCreate(mainCtx, project.ID, sha1Hash, "", bytes.NewReader(make([]byte, 750)))
+	assert.Nil(t, err)
+
+	// feature reads fall back to main
+	exists, sc, err := app.ProjectArtifactExists(featureCtx, project.ID, sha1Hash)
+	assert.Nil(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, "main", sc)
+
+	// writes land in the scope of the request only
+	_, err = app.ProjectArtifactCreate(featureCtx, project.ID, sha1Hash, "", bytes.NewReader(make([]byte, 10)))
+	assert.Nil(t, err)
+
+	a, err := app.ProjectArtifact(featureCtx, project.ID, sha1Hash)
+	assert.Nil(t, err)
+	assert.Equal(t, "feature", a.Scope)
+	assert.Equal(t, 10, a.Size)
+
+	a, err = app.ProjectArtifact(mainCtx, project.ID, sha1Hash)
+	assert.Nil(t, err)
+	assert.Equal(t, "main", a.Scope)
+	assert.Equal(t, 750, a.Size)
+
+	featureHash := rnd.RandSHA1(8)
+	_, err = app.ProjectArtifactCreate(featureCtx, project.ID, featureHash, "", bytes.NewReader(make([]byte, 10)))
+	assert.Nil(t, err)
+
+	exists, _, err = app.ProjectArtifactExists(mainCtx, project.ID, featureHash)
+	assert.Nil(t, err)
+	assert.False(t, exists)
+
+	present, missing, scopes, err := app.ProjectArtifactsExist(featureCtx, project.ID, []string{sha1Hash, featureHash})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{sha1Hash, featureHash}, present)
+	assert.Empty(t, missing)
+	assert.Equal(t, map[string]string{sha1Hash: "feature", featureHash: "feature"}, scopes)
+
+	// deletes only affect the scope of the request
+	err = app.ProjectArtifactDelete(featureCtx, project.ID, sha1Hash)
+	assert.Nil(t, err)
+
+	exists, sc, err = app.ProjectArtifactExists(featureCtx, project.ID, sha1Hash)
+	assert.Nil(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, "main", sc)
+
+	// requests without scope read the default scope and the fallback
+	exists, _, err = app.ProjectArtifactExists(adminCtx, project.ID, sha1Hash)
+	assert.Nil(t, err)
+	assert.True(t, exists)
+	exists, _, err = app.ProjectArtifactExists(adminCtx, project.ID, featureHash)
+	assert.Nil(t, err)
+	assert.False(t, exists)
+}
+
+func TestArtifactDigest(t *testing.T) {
+	app, err := setup()
+	assert.Nil(t, err)
+
+	projectName := rnd.RandStringBytesMaskImprSrc(8)
+
+	project, err := app.ProjectCreate(adminCtx, "", projectName, "a test project")
+	assert.Nil(t, err)
+
+	// sha256 of 750 zero bytes
+	digest := "d75ca3270d5d00ed061315300c640ddc1de45f79f18af434e1e00da6d6b79f2e"
+
+	sha1Hash := rnd.RandSHA1(8)
+	_, err = app.ProjectArtifactCreate(adminCtx, project.ID, sha1Hash, digest, bytes.NewReader(make([]byte, 751)))
+	assert.ErrorIs(t, err, application.ErrDigestMismatch)
+
+	exists, _, err := app.ProjectArtifactExists(adminCtx, project.ID, sha1Hash)
+	assert.Nil(t, err)
+	assert.False(t, exists)
+
+	_, err = app.ProjectArtifactCreate(adminCtx, project.ID, sha1Hash, "not-a-digest", bytes.NewReader(make([]byte, 750)))
+	assert.ErrorIs(t, err, application.ErrInvalidDigest)
+
+	_, err = app.ProjectArtifactCreate(adminCtx, project.ID, sha1Hash, digest, bytes.NewReader(make([]byte, 750)))
+	assert.Nil(t, err)
+
+	a, err := app.ProjectArtifact(adminCtx, project.ID, sha1Hash)
+	assert.Nil(t, err)
+	assert.Equal(t, digest, a.Digest)
+}
+
+func TestArtifactQuota(t *testing.T) {
+	app, err := setup()
+	assert.Nil(t, err)
+
+	projectName := rnd.RandStringBytesMaskImprSrc(8)
+
+	project, err := app.ProjectCreate(adminCtx, "", projectName, "a test project")
+	assert.Nil(t, err)
+
+	err = app.QuotaSet(adminCtx, &quota.Q{ProjectID: project.ID, MaxBytes: 1000, MaxArtifacts: 2})
+	assert.Nil(t, err)
+
+	_, err = app.ProjectArtifactCreate(adminCtx, project.ID, rnd.RandSHA1(8), "", bytes.NewReader(make([]byte, 750)))
+	assert.Nil(t, err)
+
+	// exceeds the byte limit
+	_, err = app.ProjectArtifactCreate(adminCtx, project.ID, rnd.RandSHA1(8), "", bytes.NewReader(make([]byte, 750)))
+	assert.ErrorIs(t, err, application.ErrQuotaExceeded)
+
+	_, err = app.ProjectArtifactCreate(adminCtx, project.ID, rnd.RandSHA1(8), "", bytes.NewReader(make([]byte, 250)))
+	assert.Nil(t, err)
+
+	// exceeds the artifact limit
+	_, err = app.ProjectArtifactCreate(adminCtx, project.ID, rnd.RandSHA1(8), "", bytes.NewReader(make([]byte, 0)))
+	assert.ErrorIs(t, err, application.ErrQuotaExceeded)
+
+	usage, err := app.ProjectUsage(adminCtx, project.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, quota.Usage{Bytes: 1000, Artifacts: 2}, usage)
+}
@@ -0,0 +1,162 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/benchkram/bobc/application"
+	"github.com/benchkram/bobc/pkg/organization"
+	"github.com/benchkram/bobc/pkg/principal"
+	"github.com/benchkram/bobc/pkg/rnd"
+	"github.com/benchkram/bobc/pkg/token"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrganization(t *testing.T) {
+	app, err := setup()
+	assert.Nil(t, err)
+
+	org, err := app.OrganizationCreate(adminCtx, rnd.RandStringBytesMaskImprSrc(8), "a test organization")
+	assert.Nil(t, err)
+
+	_, err = app.OrganizationCreate(adminCtx, org.Name, "")
+	assert.ErrorIs(t, err, application.ErrOrganizationAlreadyExists)
+	_, err = app.OrganizationCreate(adminCtx, strings.ToUpper(org.Name), "")
+	assert.ErrorIs(t, err, application.ErrOrganizationAlreadyExists)
+
+	_, err = app.OrganizationCreate(adminCtx, "no/slash", "")
+	assert.ErrorIs(t, err, application.ErrInvalidOrganizationName)
+
+	project, err := app.ProjectCreate(adminCtx, org.Name, rnd.RandStringBytesMaskImprSrc(8), "a test project")
+	assert.Nil(t, err)
+	assert.Equal(t, org.ID, project.OrganizationID)
+	assert.Equal(t, org.Name, project.Organization)
+
+	// projects are addressed by their path, ignoring case
+	byPath, err := app.ProjectByName(adminCtx, strings.ToUpper(project.Path()))
+	assert.Nil(t, err)
+	assert.Equal(t, project.ID, byPath.ID)
+
+	_, err = app.ProjectCreate(adminCtx, "", strings.ToUpper(project.Path()), "")
+	assert.ErrorIs(t, err, application.ErrProjectAlreadyExists)
+
+	_, err = app.ProjectCreate(adminCtx, organization.DefaultName, project.Path(), "")
+	assert.ErrorIs(t, err, application.ErrInvalidProjectName)
+
+	byPath, err = app.ProjectCreate(adminCtx, "", org.Name+"/"+rnd.RandStringBytesMaskImprSrc(8), "")
+	assert.Nil(t, err)
+	assert.Equal(t, org.ID, byPath.OrganizationID)
+
+	err = app.ProjectDelete(adminCtx, byPath.ID)
+	assert.Nil(t, err)
+
+	// projects without organization belong to the default organization
+	p, err := app.ProjectCreate(adminCtx, "", rnd.RandStringBytesMaskImprSrc(8), "a test project")
+	assert.Nil(t, err)
+	assert.Equal(t, organization.DefaultName, p.Organization)
+
+	// names of the default organization don't need the organization
+	byName, err := app.ProjectByName(adminCtx, p.Name)
+	assert.Nil(t, err)
+	assert.Equal(t, p.ID, byName.ID)
+
+	err = app.OrganizationDelete(adminCtx, org.Name)
+	assert.ErrorIs(t, err, application.ErrOrganizationNotEmpty)
+
+	err = app.ProjectDelete(adminCtx, project.ID)
+	assert.Nil(t, err)
+
+	err = app.OrganizationDelete(adminCtx, org.Name)
+	assert.Nil(t, err)
+
+	_, err = app.OrganizationByName(adminCtx, org.Name)
+	assert.ErrorIs(t, err, application.ErrOrganizationNotFound)
+}
+
+func TestMembership(t *testing.T) {
+	app, err := setup()
+	assert.Nil(t, err)
+
+	org, err := app.OrganizationCreate(adminCtx, rnd.RandStringBytesMaskImprSrc(8), "")
+	assert.Nil(t, err)
+
+	project, err := app.ProjectCreate(adminCtx, org.Name, rnd.RandStringBytesMaskImprSrc(8), "a test project")
+	assert.Nil(t, err)
+
+	other, err := app.ProjectCreate(adminCtx, "", rnd.RandStringBytesMaskImprSrc(8), "a test project")
+	assert.Nil(t, err)
+
+	u, err := app.UserCreate(adminCtx, rnd.RandStringBytesMaskImprSrc(8))
+	assert.Nil(t, err)
+
+	_, err = app.MemberSet(adminCtx, org.Name, u.Name, organization.RoleMember)
+	assert.Nil(t, err)
+
+	_, secret, err := app.TokenCreate(adminCtx, rnd.RandStringBytesMaskImprSrc(8), token.ScopeWrite, uuid.Nil, u.ID, time.Time{})
+	assert.Nil(t, err)
+
+	authenticate := func() context.Context {
+		p, err := app.TokenAuthenticate(secret)
+		assert.Nil(t, err)
+		return principal.NewContext(context.Background(), p)
+	}
+
+	// members can only read
+	ctx := authenticate()
+	_, err = app.Project(ctx, project.ID)
+	assert.Nil(t, err)
+	_, err = app.ProjectArtifactCreate(ctx, project.ID, rnd.RandSHA1(8), "", bytes.NewReader(make([]byte, 10)))
+	assert.ErrorIs(t, err, application.ErrForbidden)
+
+	// maintainers can upload, but are still limited to the organization
+	_, err = app.MemberSet(adminCtx, org.Name, u.Name, organization.RoleMaintainer)
+	assert.Nil(t, err)
+
+	ctx = authenticate()
+	_, err = app.ProjectArtifactCreate(ctx, project.ID, rnd.RandSHA1(8), "", bytes.NewReader(make([]byte, 10)))
+	assert.Nil(t, err)
+	_, err = app.Project(ctx, other.ID)
+	assert.ErrorIs(t, err, application.ErrForbidden)
+
+	projects, err := app.Projects(ctx)
+	assert.Nil(t, err)
+	assert.Len(t, projects, 1)
+
+	// owners manage the organization, but the token's scope still applies
+	_, err = app.MemberSet(adminCtx, org.Name, u.Name, organization.RoleOwner)
+	assert.Nil(t, err)
+
+	ctx = authenticate()
+	_, err = app.ProjectCreate(ctx, org.Name, rnd.RandStringBytesMaskImprSrc(8), "")
+	assert.ErrorIs(t, err, application.ErrForbidden)
+
+	members, err := app.Members(ctx, org.Name)
+	assert.Nil(t, err)
+	assert.Len(t, members, 1)
+	assert.Equal(t, u.Name, members[0].UserName)
+	assert.Equal(t, organization.RoleOwner, members[0].Role)
+
+	err = app.MemberRemove(adminCtx, org.Name, u.Name)
+	assert.Nil(t, err)
+
+	ctx = authenticate()
+	_, err = app.Project(ctx, project.ID)
+	assert.ErrorIs(t, err, application.ErrForbidden)
+
+	// non-members can't tell the organization from a missing one
+	_, err = app.OrganizationByName(ctx, org.Name)
+	assert.ErrorIs(t, err, application.ErrOrganizationNotFound)
+	_, err = app.MemberSet(ctx, org.Name, u.Name, organization.RoleOwner)
+	assert.ErrorIs(t, err, application.ErrOrganizationNotFound)
+
+	// deleting the user revokes its tokens
+	err = app.UserDelete(adminCtx, u.Name)
+	assert.Nil(t, err)
+
+	_, err = app.TokenAuthenticate(secret)
+	assert.ErrorIs(t, err, application.ErrInvalidToken)
+}
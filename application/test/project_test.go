@@ -1,9 +1,16 @@
 package test
 
 import (
+	"bytes"
+	"context"
 	"testing"
+	"time"
 
+	"github.com/benchkram/bobc/application"
+	"github.com/benchkram/bobc/pkg/principal"
 	"github.com/benchkram/bobc/pkg/rnd"
+	"github.com/benchkram/bobc/pkg/token"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -13,13 +20,99 @@ func TestProjectCreation(t *testing.T) {
 
 	projectName := rnd.RandStringBytesMaskImprSrc(8)
 
-	project, err := app.ProjectCreate(projectName, "")
+	project, err := app.ProjectCreate(adminCtx, "", projectName, "")
 	assert.Nil(t, err)
 
-	exists, err := app.ProjectExists(projectName)
+	exists, err := app.ProjectExists(adminCtx, projectName)
 	assert.Nil(t, err)
 	assert.True(t, exists)
 
-	_, err = app.Project(project.ID)
+	_, err = app.Project(adminCtx, project.ID)
 	assert.Nil(t, err)
 }
+
+func TestPublicProject(t *testing.T) {
+	app, err := setup()
+	assert.Nil(t, err)
+
+	project, err := app.ProjectCreate(adminCtx, "", rnd.RandStringBytesMaskImprSrc(8), "")
+	assert.Nil(t, err)
+
+	artifactID := rnd.RandSHA1(8)
+	_, err = app.ProjectArtifactCreate(adminCtx, project.ID, artifactID, "", bytes.NewReader(make([]byte, 10)))
+	assert.Nil(t, err)
+
+	anonymous := principal.NewContext(context.Background(), principal.Anonymous())
+
+	// anonymous callers can't tell private projects from missing ones
+	_, err = app.ProjectByName(anonymous, project.Name)
+	assert.ErrorIs(t, err, application.ErrUnauthenticated)
+	_, err = app.ProjectByName(anonymous, rnd.RandStringBytesMaskImprSrc(8))
+	assert.ErrorIs(t, err, application.ErrUnauthenticated)
+
+	// private projects ask anonymous callers for credentials
+	_, err = app.ProjectArtifact(anonymous, project.ID, artifactID)
+	assert.ErrorIs(t, err, application.ErrUnauthenticated)
+
+	err = app.ProjectVisibilitySet(adminCtx, project.ID, true)
+	assert.Nil(t, err)
+
+	_, err = app.ProjectArtifact(anonymous, project.ID, artifactID)
+	assert.Nil(t, err)
+
+	p, err := app.ProjectByName(anonymous, project.Name)
+	assert.Nil(t, err)
+	assert.True(t, p.Public)
+
+	// writing still requires credentials
+	_, err = app.ProjectArtifactCreate(anonymous, project.ID, rnd.RandSHA1(8), "", bytes.NewReader(make([]byte, 10)))
+	assert.ErrorIs(t, err, application.ErrUnauthenticated)
+
+	err = app.ProjectArtifactDelete(anonymous, project.ID, artifactID)
+	assert.ErrorIs(t, err, application.ErrUnauthenticated)
+
+	// tokens of other projects can read, but not write
+	other, err := app.ProjectCreate(adminCtx, "", rnd.RandStringBytesMaskImprSrc(8), "")
+	assert.Nil(t, err)
+
+	tok, _, err := app.TokenCreate(adminCtx, rnd.RandStringBytesMaskImprSrc(8), token.ScopeWrite, other.ID, uuid.Nil, time.Time{})
+	assert.Nil(t, err)
+	ctx := principal.NewContext(context.Background(), principal.FromToken(tok))
+
+	_, err = app.ProjectArtifact(ctx, project.ID, artifactID)
+	assert.Nil(t, err)
+
+	// neither can callers without access to the project
+	private, err := app.ProjectCreate(adminCtx, "", rnd.RandStringBytesMaskImprSrc(8), "")
+	assert.Nil(t, err)
+	_, err = app.ProjectByName(ctx, private.Name)
+	assert.ErrorIs(t, err, application.ErrProjectNotFound)
+	_, err = app.ProjectIDByName(ctx, private.Name)
+	assert.ErrorIs(t, err, application.ErrProjectNotFound)
+
+	// private and missing projects can't be told apart
+	exists, err := app.ProjectExists(ctx, private.Name)
+	assert.Nil(t, err)
+	assert.False(t, exists)
+	exists, err = app.ProjectExists(ctx, rnd.RandStringBytesMaskImprSrc(8))
+	assert.Nil(t, err)
+	assert.False(t, exists)
+	exists, err = app.ProjectExists(ctx, project.Name)
+	assert.Nil(t, err)
+	assert.True(t, exists)
+
+	_, err = app.ProjectExists(anonymous, rnd.RandStringBytesMaskImprSrc(8))
+	assert.ErrorIs(t, err, application.ErrUnauthenticated)
+
+	_, err = app.ProjectArtifactCreate(ctx, project.ID, rnd.RandSHA1(8), "", bytes.NewReader(make([]byte, 10)))
+	assert.ErrorIs(t, err, application.ErrForbidden)
+
+	err = app.ProjectVisibilitySet(ctx, project.ID, false)
+	assert.ErrorIs(t, err, application.ErrForbidden)
+
+	err = app.ProjectVisibilitySet(adminCtx, project.ID, false)
+	assert.Nil(t, err)
+
+	_, err = app.ProjectArtifact(anonymous, project.ID, artifactID)
+	assert.ErrorIs(t, err, application.ErrUnauthenticated)
+}
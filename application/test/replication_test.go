@@ -0,0 +1,70 @@
+package test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/benchkram/bobc/pkg/rnd"
+	"github.com/benchkram/bobc/pkg/scope"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplication(t *testing.T) {
+	app, err := setup()
+	assert.Nil(t, err)
+
+	target := &fakeUpstream{artifacts: map[string][]byte{}}
+
+	project, err := app.ProjectCreate(adminCtx, "", rnd.RandStringBytesMaskImprSrc(8), "a test project")
+	assert.Nil(t, err)
+
+	present := rnd.RandSHA1(8)
+	_, err = app.ProjectArtifactCreate(adminCtx, project.ID, present, "", bytes.NewReader(make([]byte, 10)))
+	assert.Nil(t, err)
+	target.artifacts[key(project.Path(), "", present)] = make([]byte, 10)
+
+	featureCtx := scope.NewContext(adminCtx, "feature")
+	missing := rnd.RandSHA1(8)
+	_, err = app.ProjectArtifactCreate(featureCtx, project.ID, missing, "", bytes.NewReader(make([]byte, 20)))
+	assert.Nil(t, err)
+
+	// artifacts are compared per scope, the one on target is in another scope
+	_, err = app.ProjectArtifactCreate(featureCtx, project.ID, present, "", bytes.NewReader(make([]byte, 30)))
+	assert.Nil(t, err)
+
+	report, err := app.Replicate(target, []string{project.Path()})
+	assert.Nil(t, err)
+	assert.Len(t, report.Projects, 1)
+	assert.Equal(t, 3, report.Projects[0].Checked)
+	assert.Equal(t, 2, report.Projects[0].Transferred)
+	assert.Equal(t, 0, report.Failed())
+	assert.True(t, target.has(project.Path(), "feature", missing))
+	assert.Len(t, target.artifacts[key(project.Path(), "feature", present)], 30)
+	assert.Len(t, target.artifacts[key(project.Path(), "", present)], 10)
+
+	// artifacts already replicated are not transferred again
+	report, err = app.Replicate(target, []string{project.Path()})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, report.Transferred())
+
+	// projects missing on target are created along with their organization
+	org, err := app.OrganizationCreate(adminCtx, rnd.RandStringBytesMaskImprSrc(8), "replicated organization")
+	assert.Nil(t, err)
+
+	created, err := app.ProjectCreate(adminCtx, org.Name, rnd.RandStringBytesMaskImprSrc(8), "replicated project")
+	assert.Nil(t, err)
+	err = app.ProjectVisibilitySet(adminCtx, created.ID, true)
+	assert.Nil(t, err)
+
+	id := rnd.RandSHA1(8)
+	_, err = app.ProjectArtifactCreate(adminCtx, created.ID, id, "", bytes.NewReader(make([]byte, 10)))
+	assert.Nil(t, err)
+
+	report, err = app.Replicate(target, []string{created.Path()})
+	assert.Nil(t, err)
+	assert.Nil(t, report.Projects[0].Err)
+	assert.Equal(t, 1, report.Transferred())
+	assert.Equal(t, "replicated organization", target.orgs[org.Name])
+	assert.Equal(t, fakeProject{description: "replicated project", public: true}, target.projects[created.Path()])
+	assert.True(t, target.has(created.Path(), "", id))
+}
@@ -0,0 +1,102 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/benchkram/bobc/application"
+	"github.com/benchkram/bobc/pkg/projectrepo"
+	"github.com/benchkram/bobc/pkg/retention"
+	"github.com/benchkram/bobc/pkg/rnd"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// evictionRepository fails the first artifact deletion and reports
+// the second one as not found, as if it was deleted concurrently.
+type evictionRepository struct {
+	application.ProjectRepository
+
+	// projects limits the policies enforced to the projects of the test
+	projects map[uuid.UUID]bool
+	deletes  int
+}
+
+func (r *evictionRepository) RetentionPolicies(ctx context.Context) ([]*retention.Policy, error) {
+	policies, err := r.ProjectRepository.RetentionPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := []*retention.Policy{}
+	for _, p := range policies {
+		if r.projects[p.ProjectID] {
+			filtered = append(filtered, p)
+		}
+	}
+	return filtered, nil
+}
+
+func (r *evictionRepository) ProjectArtifactDelete(ctx context.Context, projectID uuid.UUID, scope, artifactID string) error {
+	r.deletes++
+	switch r.deletes {
+	case 1:
+		return errors.New("storage unavailable")
+	case 2:
+		err := r.ProjectRepository.ProjectArtifactDelete(ctx, projectID, scope, artifactID)
+		if err != nil {
+			return err
+		}
+		return projectrepo.ErrNotFound
+	}
+	return r.ProjectRepository.ProjectArtifactDelete(ctx, projectID, scope, artifactID)
+}
+
+func TestRetentionEnforce(t *testing.T) {
+	repo := &evictionRepository{
+		ProjectRepository: projectrepo.New(newDatabase(), newArtifactStore()),
+		projects:          map[uuid.UUID]bool{},
+	}
+	app, err := setup(application.WithProjectRepository(repo))
+	assert.Nil(t, err)
+
+	projects := []uuid.UUID{}
+	for i := 0; i < 2; i++ {
+		project, err := app.ProjectCreate(adminCtx, "", rnd.RandStringBytesMaskImprSrc(8), "")
+		assert.Nil(t, err)
+		projects = append(projects, project.ID)
+		repo.projects[project.ID] = true
+
+		for j := 0; j < 3; j++ {
+			_, err = app.ProjectArtifactCreate(adminCtx, project.ID, rnd.RandSHA1(8), "", bytes.NewReader(make([]byte, 10)))
+			assert.Nil(t, err)
+		}
+
+		err = app.RetentionPolicySet(adminCtx, &retention.Policy{ProjectID: project.ID, KeepLast: 1})
+		assert.Nil(t, err)
+	}
+
+	err = app.RetentionEnforce()
+	assert.Nil(t, err)
+	assert.Equal(t, 4, repo.deletes)
+
+	// the failed eviction doesn't stop the remaining ones
+	remaining := []int{}
+	for _, id := range projects {
+		project, err := app.Project(adminCtx, id)
+		assert.Nil(t, err)
+		remaining = append(remaining, len(project.Artifacts))
+	}
+	assert.ElementsMatch(t, []int{1, 2}, remaining)
+
+	// the failed eviction is retried on the next run
+	err = app.RetentionEnforce()
+	assert.Nil(t, err)
+	for _, id := range projects {
+		project, err := app.Project(adminCtx, id)
+		assert.Nil(t, err)
+		assert.Len(t, project.Artifacts, 1)
+	}
+}
@@ -7,13 +7,35 @@ import (
 	"github.com/benchkram/bobc/application"
 	"github.com/benchkram/bobc/pkg/artifactstore"
 	"github.com/benchkram/bobc/pkg/db"
+	"github.com/benchkram/bobc/pkg/orgrepo"
+	"github.com/benchkram/bobc/pkg/principal"
 	"github.com/benchkram/bobc/pkg/projectrepo"
+	"github.com/benchkram/bobc/pkg/tokenrepo"
 	"github.com/benchkram/errz"
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
 )
 
-func setup() (application.Application, error) {
+// adminCtx carries a principal with full access.
+var adminCtx = principal.NewContext(context.Background(), principal.Admin("test"))
+
+func setup(opts ...application.Option) (application.Application, error) {
+	db := newDatabase()
+
+	projectRepo := projectrepo.New(db, newArtifactStore())
+	tokenRepo := tokenrepo.New(db)
+	orgRepo := orgrepo.New(db)
+
+	return application.New(append([]application.Option{
+		application.WithProjectRepository(projectRepo),
+		application.WithTokenRepository(tokenRepo),
+		application.WithOrganizationRepository(orgRepo),
+		application.WithScopeFallback([]string{"main"}),
+	}, opts...)...), nil
+}
+
+// newDatabase connects to the test database.
+func newDatabase() db.Database {
 	db := db.New(
 		db.WithPostgres(
 			databasePostgres.Config().Host,
@@ -27,6 +49,11 @@ func setup() (application.Application, error) {
 	err := db.Connect()
 	errz.Fatal(err)
 
+	return db
+}
+
+// newArtifactStore returns a store using the test bucket, creating it if missing.
+func newArtifactStore() *artifactstore.Repository {
 	minioConfig := minioInstance.Config()
 	minioClient, err := minio.New(minioConfig.Endpoint, &minio.Options{
 		Creds:  credentials.NewStaticV4(minioConfig.AccessKeyID, minioConfig.SecretAccessKey, ""),
@@ -51,14 +78,8 @@ func setup() (application.Application, error) {
 		log.Printf("Successfully created %s\n", bucketName)
 	}
 
-	artifactStore := artifactstore.New(
+	return artifactstore.New(
 		minioClient,
 		artifactstore.WithBucketName(bucketName),
 	)
-
-	projectRepo := projectrepo.New(db, artifactStore)
-
-	return application.New(
-		application.WithProjectRepository(projectRepo),
-	), nil
 }
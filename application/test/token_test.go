@@ -0,0 +1,75 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/benchkram/bobc/application"
+	"github.com/benchkram/bobc/pkg/principal"
+	"github.com/benchkram/bobc/pkg/rnd"
+	"github.com/benchkram/bobc/pkg/token"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToken(t *testing.T) {
+	app, err := setup()
+	assert.Nil(t, err)
+
+	project, err := app.ProjectCreate(adminCtx, "", rnd.RandStringBytesMaskImprSrc(8), "a test project")
+	assert.Nil(t, err)
+
+	name := rnd.RandStringBytesMaskImprSrc(8)
+	tok, secret, err := app.TokenCreate(adminCtx, name, token.ScopeWrite, project.ID, uuid.Nil, time.Time{})
+	assert.Nil(t, err)
+
+	_, _, err = app.TokenCreate(adminCtx, name, token.ScopeRead, uuid.Nil, uuid.Nil, time.Time{})
+	assert.ErrorIs(t, err, application.ErrTokenAlreadyExists)
+
+	verified, err := app.TokenVerify(secret)
+	assert.Nil(t, err)
+	assert.Equal(t, tok.ID, verified.ID)
+	assert.Equal(t, project.ID, verified.ProjectID)
+
+	_, err = app.TokenVerify(secret + "0")
+	assert.ErrorIs(t, err, application.ErrInvalidToken)
+
+	err = app.TokenRevoke(adminCtx, tok.ID)
+	assert.Nil(t, err)
+
+	_, err = app.TokenVerify(secret)
+	assert.ErrorIs(t, err, application.ErrInvalidToken)
+}
+
+func TestAuthorization(t *testing.T) {
+	app, err := setup()
+	assert.Nil(t, err)
+
+	project, err := app.ProjectCreate(adminCtx, "", rnd.RandStringBytesMaskImprSrc(8), "a test project")
+	assert.Nil(t, err)
+
+	other, err := app.ProjectCreate(adminCtx, "", rnd.RandStringBytesMaskImprSrc(8), "a test project")
+	assert.Nil(t, err)
+
+	tok, _, err := app.TokenCreate(adminCtx, rnd.RandStringBytesMaskImprSrc(8), token.ScopeWrite, project.ID, uuid.Nil, time.Time{})
+	assert.Nil(t, err)
+	ctx := principal.NewContext(context.Background(), principal.FromToken(tok))
+
+	_, err = app.ProjectArtifactCreate(ctx, project.ID, rnd.RandSHA1(8), "", bytes.NewReader(make([]byte, 10)))
+	assert.Nil(t, err)
+
+	_, err = app.ProjectArtifactCreate(ctx, other.ID, rnd.RandSHA1(8), "", bytes.NewReader(make([]byte, 10)))
+	assert.ErrorIs(t, err, application.ErrForbidden)
+
+	err = app.ProjectDelete(ctx, project.ID)
+	assert.ErrorIs(t, err, application.ErrForbidden)
+
+	projects, err := app.Projects(ctx)
+	assert.Nil(t, err)
+	assert.Len(t, projects, 1)
+
+	_, err = app.Projects(context.Background())
+	assert.ErrorIs(t, err, application.ErrUnauthenticated)
+}
@@ -0,0 +1,45 @@
+package test
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/benchkram/bobc/application"
+	"github.com/benchkram/bobc/pkg/rnd"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDirectUpload(t *testing.T) {
+	app, err := setup()
+	assert.Nil(t, err)
+
+	projectName := rnd.RandStringBytesMaskImprSrc(8)
+
+	project, err := app.ProjectCreate(adminCtx, "", projectName, "a test project")
+	assert.Nil(t, err)
+
+	sha1Hash := rnd.RandSHA1(8)
+	u, err := app.DirectUploadCreate(adminCtx, project.ID, sha1Hash, "", 1)
+	assert.Nil(t, err)
+	assert.Len(t, u.Links, 1)
+
+	// completing before the payload arrived fails
+	_, err = app.UploadComplete(adminCtx, project.ID, u.ID)
+	assert.ErrorIs(t, err, application.ErrUploadIncomplete)
+
+	// upload directly to s3 through presigned link
+	req, err := http.NewRequest(http.MethodPut, u.Links[0].String(), bytes.NewReader(make([]byte, 750)))
+	assert.Nil(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	a, err := app.UploadComplete(adminCtx, project.ID, u.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, 750, a.Size)
+
+	exists, _, err := app.ProjectArtifactExists(adminCtx, project.ID, sha1Hash)
+	assert.Nil(t, err)
+	assert.True(t, exists)
+}
@@ -0,0 +1,202 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/benchkram/bobc/application"
+	"github.com/benchkram/bobc/pkg/artifact"
+	"github.com/benchkram/bobc/pkg/project"
+	"github.com/benchkram/bobc/pkg/rnd"
+	"github.com/benchkram/bobc/pkg/scope"
+	"github.com/benchkram/bobc/pkg/upstream"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeUpstream holds payloads by project path, scope and artifact id.
+// Projects exist once they hold an artifact or were created.
+type fakeUpstream struct {
+	mux       sync.Mutex
+	artifacts map[string][]byte
+	fetches   int
+
+	// orgs holds the descriptions of the organizations created
+	orgs map[string]string
+	// projects holds the projects created by path
+	projects map[string]fakeProject
+}
+
+type fakeProject struct {
+	description string
+	public      bool
+}
+
+func key(projectPath, scope, artifactID string) string {
+	return projectPath + "|" + scope + "|" + artifactID
+}
+
+func (u *fakeUpstream) Artifact(_ context.Context, projectPath, sc, artifactID string) (*artifact.A, io.ReadCloser, error) {
+	u.mux.Lock()
+	defer u.mux.Unlock()
+
+	payload, ok := u.artifacts[key(projectPath, sc, artifactID)]
+	if !ok {
+		return nil, nil, upstream.ErrNotFound
+	}
+	u.fetches++
+
+	return &artifact.A{ID: artifactID, Scope: sc, Size: len(payload)}, ioutil.NopCloser(bytes.NewReader(payload)), nil
+}
+
+func (u *fakeUpstream) ArtifactCreate(_ context.Context, projectPath, sc, artifactID, digest string, src io.Reader) error {
+	payload, err := ioutil.ReadAll(src)
+	if err != nil {
+		return err
+	}
+
+	u.mux.Lock()
+	defer u.mux.Unlock()
+
+	u.artifacts[key(projectPath, sc, artifactID)] = payload
+
+	return nil
+}
+
+func (u *fakeUpstream) ArtifactsExist(_ context.Context, projectPath, sc string, artifactIDs []string) (map[string]string, error) {
+	u.mux.Lock()
+	defer u.mux.Unlock()
+
+	if !u.exists(projectPath) {
+		return nil, upstream.ErrNotFound
+	}
+
+	scopes := map[string]string{}
+	for _, id := range artifactIDs {
+		if _, ok := u.artifacts[key(projectPath, sc, id)]; ok {
+			scopes[id] = sc
+		}
+	}
+
+	return scopes, nil
+}
+
+// exists reports if the project at projectPath exists, u must be locked.
+func (u *fakeUpstream) exists(projectPath string) bool {
+	if _, ok := u.projects[projectPath]; ok {
+		return true
+	}
+	for k := range u.artifacts {
+		if strings.HasPrefix(k, projectPath+"|") {
+			return true
+		}
+	}
+	return false
+}
+
+func (u *fakeUpstream) Address() string {
+	return "fake"
+}
+
+func (u *fakeUpstream) OrganizationCreate(_ context.Context, name, description string) error {
+	u.mux.Lock()
+	defer u.mux.Unlock()
+
+	if u.orgs == nil {
+		u.orgs = map[string]string{}
+	}
+	if _, ok := u.orgs[name]; !ok {
+		u.orgs[name] = description
+	}
+
+	return nil
+}
+
+func (u *fakeUpstream) ProjectCreate(_ context.Context, projectPath, description string, public bool) error {
+	u.mux.Lock()
+	defer u.mux.Unlock()
+
+	org, _ := project.SplitPath(projectPath)
+	if _, ok := u.orgs[org]; !ok {
+		return fmt.Errorf("organization %s missing", org)
+	}
+
+	if u.projects == nil {
+		u.projects = map[string]fakeProject{}
+	}
+	if _, ok := u.projects[projectPath]; !ok {
+		u.projects[projectPath] = fakeProject{description: description, public: public}
+	}
+
+	return nil
+}
+
+func (u *fakeUpstream) has(projectPath, sc, artifactID string) bool {
+	u.mux.Lock()
+	defer u.mux.Unlock()
+
+	_, ok := u.artifacts[key(projectPath, sc, artifactID)]
+	return ok
+}
+
+func TestUpstream(t *testing.T) {
+	up := &fakeUpstream{artifacts: map[string][]byte{}}
+
+	app, err := setup(
+		application.WithUpstream(up),
+		application.WithUpstreamForward(true),
+	)
+	assert.Nil(t, err)
+
+	project, err := app.ProjectCreate(adminCtx, "", rnd.RandStringBytesMaskImprSrc(8), "a test project")
+	assert.Nil(t, err)
+
+	// artifacts missing locally are fetched from the fallback scope upstream
+	id := rnd.RandSHA1(8)
+	up.artifacts[key(project.Path(), "main", id)] = make([]byte, 100)
+
+	featureCtx := scope.NewContext(adminCtx, "feature")
+	exists, sc, err := app.ProjectArtifactExists(featureCtx, project.ID, id)
+	assert.Nil(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, "main", sc)
+
+	a, err := app.ProjectArtifact(featureCtx, project.ID, id)
+	assert.Nil(t, err)
+	assert.Equal(t, 100, a.Size)
+	assert.Equal(t, "main", a.Scope)
+	assert.Equal(t, 1, up.fetches)
+
+	exists, _, err = app.ProjectArtifactExists(adminCtx, project.ID, rnd.RandSHA1(8))
+	assert.Nil(t, err)
+	assert.False(t, exists)
+
+	// batch lookups consult the upstream, the artifacts are fetched once read
+	batched := rnd.RandSHA1(8)
+	up.artifacts[key(project.Path(), "feature", batched)] = make([]byte, 50)
+	missing := rnd.RandSHA1(8)
+
+	present, absent, scopes, err := app.ProjectArtifactsExist(featureCtx, project.ID, []string{id, batched, missing})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{id, batched}, present)
+	assert.Equal(t, []string{missing}, absent)
+	assert.Equal(t, "feature", scopes[batched])
+	assert.Equal(t, 1, up.fetches)
+
+	// uploads are forwarded in the background
+	id = rnd.RandSHA1(8)
+	_, err = app.ProjectArtifactCreate(featureCtx, project.ID, id, "", bytes.NewReader(make([]byte, 10)))
+	assert.Nil(t, err)
+	assert.Eventually(t, func() bool {
+		return up.has(project.Path(), "feature", id)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	_, _, err = app.ProjectArtifactExists(context.Background(), project.ID, id)
+	assert.ErrorIs(t, err, application.ErrUnauthenticated)
+}
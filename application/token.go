@@ -0,0 +1,178 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/benchkram/bobc/pkg/logging"
+	"github.com/benchkram/bobc/pkg/orgrepo"
+	"github.com/benchkram/bobc/pkg/principal"
+	"github.com/benchkram/bobc/pkg/token"
+	"github.com/benchkram/bobc/pkg/tokenrepo"
+	"github.com/benchkram/bobc/pkg/tracing"
+	"github.com/benchkram/errz"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// maxTokenNameLength limits the length of token names.
+const maxTokenNameLength = 128
+
+// TokenCreate creates an api token and returns it along with its secret.
+// The secret is not stored and can't be retrieved later on.
+// Pass uuid.Nil as projectID for a token valid for all projects, uuid.Nil
+// as userID for a token not acting for a user and a zero expiresAt for a
+// token which never expires.
+func (s *application) TokenCreate(ctx context.Context, name string, scope token.Scope, projectID, userID uuid.UUID, expiresAt time.Time) (_ *token.T, secret string, err error) {
+	ctx, span := tracing.Start(ctx, "application.TokenCreate")
+	defer tracing.End(span, &err)
+	defer errz.Recover(&err)
+
+	err = s.authorize(ctx, projectID, token.ScopeAdmin)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if name == "" || len(name) > maxTokenNameLength {
+		return nil, "", ErrInvalidTokenName
+	}
+	if !scope.Valid() {
+		return nil, "", ErrInvalidTokenScope
+	}
+	if !expiresAt.IsZero() && !expiresAt.After(time.Now()) {
+		return nil, "", ErrInvalidTokenExpiry
+	}
+
+	if projectID != uuid.Nil {
+		_, err = s.Project(ctx, projectID)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	if userID != uuid.Nil {
+		// acting for a user is only handed out by global admins
+		err = s.authorize(ctx, uuid.Nil, token.ScopeAdmin)
+		if err != nil {
+			return nil, "", err
+		}
+
+		_, err = s.orgs.User(userID)
+		if errors.Is(err, orgrepo.ErrNotFound) {
+			return nil, "", ErrUserNotFound
+		}
+		errz.Fatal(err)
+	}
+
+	secret, err = token.NewSecret()
+	errz.Fatal(err)
+
+	t := &token.T{
+		ID:        uuid.New(),
+		Name:      name,
+		Scope:     scope,
+		ProjectID: projectID,
+		UserID:    userID,
+		ExpiresAt: expiresAt,
+		CreatedAt: time.Now(),
+	}
+
+	err = s.tokens.TokenCreate(t, token.Hash(secret))
+	if errors.Is(err, tokenrepo.ErrAlreadyExists) {
+		return nil, "", ErrTokenAlreadyExists
+	}
+	errz.Fatal(err)
+
+	log.Ctx(ctx).Info().
+		Str("token", t.Name).
+		Str(logging.FieldSubject, subject(ctx)).
+		Msg("Token created.")
+
+	return t, secret, nil
+}
+
+func (s *application) Tokens(ctx context.Context) (_ []*token.T, err error) {
+	ctx, span := tracing.Start(ctx, "application.Tokens")
+	defer tracing.End(span, &err)
+	defer errz.Recover(&err)
+
+	err = s.authorize(ctx, uuid.Nil, token.ScopeAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.tokens.Tokens()
+}
+
+func (s *application) TokenRevoke(ctx context.Context, id uuid.UUID) (err error) {
+	ctx, span := tracing.Start(ctx, "application.TokenRevoke")
+	defer tracing.End(span, &err)
+	defer errz.Recover(&err)
+
+	err = s.authorize(ctx, uuid.Nil, token.ScopeAdmin)
+	if err != nil {
+		return err
+	}
+
+	err = s.tokens.TokenDelete(id)
+	if errors.Is(err, tokenrepo.ErrNotFound) {
+		return ErrTokenNotFound
+	}
+	errz.Fatal(err)
+
+	log.Ctx(ctx).Info().
+		Str("token_id", id.String()).
+		Str(logging.FieldSubject, subject(ctx)).
+		Msg("Token revoked.")
+
+	return nil
+}
+
+// TokenVerify returns the token matching secret.
+// Unknown and expired tokens are rejected with ErrInvalidToken.
+func (s *application) TokenVerify(secret string) (_ *token.T, err error) {
+	defer errz.Recover(&err)
+
+	if !token.IsToken(secret) {
+		return nil, ErrInvalidToken
+	}
+
+	t, err := s.tokens.TokenByHash(token.Hash(secret))
+	if errors.Is(err, tokenrepo.ErrNotFound) {
+		return nil, ErrInvalidToken
+	}
+	errz.Fatal(err)
+
+	if t.Expired(time.Now()) {
+		return nil, ErrInvalidToken
+	}
+
+	return t, nil
+}
+
+// TokenAuthenticate returns the principal of the token matching secret.
+// Tokens acting for a user are limited by the user's roles.
+func (s *application) TokenAuthenticate(secret string) (_ *principal.P, err error) {
+	defer errz.Recover(&err)
+
+	t, err := s.TokenVerify(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.UserID == uuid.Nil {
+		return principal.FromToken(t), nil
+	}
+
+	u, err := s.orgs.User(t.UserID)
+	if errors.Is(err, orgrepo.ErrNotFound) {
+		return nil, ErrInvalidToken
+	}
+	errz.Fatal(err)
+
+	memberships, err := s.orgs.Memberships(u.ID)
+	errz.Fatal(err)
+
+	return principal.FromUserToken(t, u.Name, memberships), nil
+}
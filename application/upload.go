@@ -0,0 +1,257 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/benchkram/bobc/pkg/artifact"
+	"github.com/benchkram/bobc/pkg/logging"
+	"github.com/benchkram/bobc/pkg/projectrepo"
+	"github.com/benchkram/bobc/pkg/scope"
+	"github.com/benchkram/bobc/pkg/token"
+	"github.com/benchkram/bobc/pkg/tracing"
+	"github.com/benchkram/bobc/pkg/upload"
+	"github.com/benchkram/errz"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// maxUploadParts is the maximum number of parts supported by s3.
+const maxUploadParts = 10000
+
+// UploadCreate starts a resumable upload of an artifact.
+// digest is the expected checksum of the payload and can be empty.
+func (s *application) UploadCreate(ctx context.Context, projectID uuid.UUID, artifactID, digest string) (_ *upload.U, err error) {
+	ctx, span := tracing.Start(ctx, "application.UploadCreate")
+	defer tracing.End(span, &err)
+	defer errz.Recover(&err)
+
+	err = s.authorize(ctx, projectID, token.ScopeWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	digest, err = normalizeDigest(digest)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := s.artifactExists(ctx, projectID, artifactID)
+	if err != nil {
+		return nil, err
+	}
+
+	if exists {
+		return nil, ErrArtifactAlreadyExists
+	}
+
+	_, err = s.quotaRemaining(ctx, projectID, 0, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.projects.UploadCreate(ctx, projectID, scope.FromContext(ctx), artifactID, digest, time.Now().Add(s.uploadExpiry))
+}
+
+// DirectUploadCreate starts an upload of an artifact which the client sends
+// directly to the artifact store in the given number of parts.
+func (s *application) DirectUploadCreate(ctx context.Context, projectID uuid.UUID, artifactID, digest string, parts int) (_ *upload.U, err error) {
+	ctx, span := tracing.Start(ctx, "application.DirectUploadCreate")
+	defer tracing.End(span, &err)
+	defer errz.Recover(&err)
+
+	err = s.authorize(ctx, projectID, token.ScopeWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	digest, err = normalizeDigest(digest)
+	if err != nil {
+		return nil, err
+	}
+
+	if parts < 0 || parts > maxUploadParts {
+		return nil, ErrInvalidPartNumber
+	}
+
+	exists, err := s.artifactExists(ctx, projectID, artifactID)
+	if err != nil {
+		return nil, err
+	}
+
+	if exists {
+		return nil, ErrArtifactAlreadyExists
+	}
+
+	_, err = s.quotaRemaining(ctx, projectID, 0, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := s.projects.DirectUploadCreate(ctx, projectID, scope.FromContext(ctx), artifactID, digest, parts, time.Now().Add(s.uploadExpiry))
+	if errors.Is(err, projectrepo.ErrDirectUploadUnsupported) {
+		return nil, ErrDirectUploadUnsupported
+	}
+	errz.Fatal(err)
+
+	return u, nil
+}
+
+func (s *application) Upload(ctx context.Context, projectID, uploadID uuid.UUID) (_ *upload.U, err error) {
+	ctx, span := tracing.Start(ctx, "application.Upload")
+	defer tracing.End(span, &err)
+	defer errz.Recover(&err)
+
+	err = s.authorize(ctx, projectID, token.ScopeWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := s.projects.Upload(ctx, projectID, uploadID)
+	if errors.Is(err, projectrepo.ErrNotFound) {
+		return nil, ErrUploadNotFound
+	}
+	errz.Fatal(err)
+
+	return u, nil
+}
+
+// UploadPart stores a part of an upload. Parts are numbered starting at 1.
+// The part is rejected if the upload would exceed the quota of the project.
+func (s *application) UploadPart(ctx context.Context, projectID, uploadID uuid.UUID, number int, src io.Reader, size int64) (err error) {
+	ctx, span := tracing.Start(ctx, "application.UploadPart")
+	defer tracing.End(span, &err)
+	defer errz.Recover(&err)
+
+	err = s.authorize(ctx, projectID, token.ScopeWrite)
+	if err != nil {
+		return err
+	}
+
+	if number < 1 || number > maxUploadParts {
+		return ErrInvalidPartNumber
+	}
+
+	u, err := s.Upload(ctx, projectID, uploadID)
+	if err != nil {
+		return err
+	}
+
+	// a part sent again replaces the previous one
+	total := size
+	for _, p := range u.Parts {
+		if p.Number != number {
+			total += p.Size
+		}
+	}
+
+	_, err = s.quotaRemaining(ctx, projectID, total, 1)
+	if err != nil {
+		return err
+	}
+
+	err = s.projects.UploadPart(ctx, projectID, uploadID, number, src, size)
+	if errors.Is(err, projectrepo.ErrNotFound) {
+		return ErrUploadNotFound
+	} else if errors.Is(err, projectrepo.ErrUploadDirect) {
+		return ErrUploadDirect
+	}
+	errz.Fatal(err)
+
+	return nil
+}
+
+// UploadComplete creates the artifact from the parts of an upload.
+// Direct uploads exceeding the quota are discarded, as their size
+// is only known once the payload has been assembled.
+func (s *application) UploadComplete(ctx context.Context, projectID, uploadID uuid.UUID) (_ *artifact.A, err error) {
+	ctx, span := tracing.Start(ctx, "application.UploadComplete")
+	defer tracing.End(span, &err)
+	defer errz.Recover(&err)
+
+	err = s.authorize(ctx, projectID, token.ScopeWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := s.Upload(ctx, projectID, uploadID)
+	errz.Fatal(err)
+
+	// the artifact might have been uploaded by other means in the meantime
+	exists, err := s.artifactExists(scope.NewContext(ctx, u.Scope), projectID, u.ArtifactID)
+	errz.Fatal(err)
+
+	if exists {
+		return nil, ErrArtifactAlreadyExists
+	}
+
+	// other artifacts might have been created since the upload started
+	remaining, err := s.quotaRemaining(ctx, projectID, 0, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := s.projects.UploadComplete(ctx, projectID, uploadID, remaining)
+	if errors.Is(err, projectrepo.ErrQuotaExceeded) {
+		return nil, ErrQuotaExceeded
+	} else if errors.Is(err, projectrepo.ErrNotFound) {
+		return nil, ErrUploadNotFound
+	} else if errors.Is(err, projectrepo.ErrUploadIncomplete) {
+		return nil, ErrUploadIncomplete
+	} else if errors.Is(err, projectrepo.ErrDirectUploadUnsupported) {
+		return nil, ErrDirectUploadUnsupported
+	} else if errors.Is(err, projectrepo.ErrDigestMismatch) {
+		return nil, ErrDigestMismatch
+	}
+	errz.Fatal(err)
+
+	log.Ctx(ctx).Info().
+		Str(logging.FieldProjectID, projectID.String()).
+		Str(logging.FieldArtifactID, a.ID).
+		Str(logging.FieldScope, a.Scope).
+		Int("size", a.Size).
+		Str("digest", a.Digest).
+		Str(logging.FieldSubject, subject(ctx)).
+		Msg("Artifact created.")
+
+	s.forwardUpstream(ctx, projectID, a)
+
+	return a, nil
+}
+
+func (s *application) UploadAbort(ctx context.Context, projectID, uploadID uuid.UUID) (err error) {
+	ctx, span := tracing.Start(ctx, "application.UploadAbort")
+	defer tracing.End(span, &err)
+	defer errz.Recover(&err)
+
+	err = s.authorize(ctx, projectID, token.ScopeWrite)
+	if err != nil {
+		return err
+	}
+
+	err = s.projects.UploadAbort(ctx, projectID, uploadID)
+	if errors.Is(err, projectrepo.ErrNotFound) {
+		return ErrUploadNotFound
+	}
+	errz.Fatal(err)
+
+	return nil
+}
+
+// UploadsExpire discards uploads which have not been completed in time.
+func (s *application) UploadsExpire() (err error) {
+	ctx, span := tracing.Start(context.Background(), "application.UploadsExpire")
+	defer tracing.End(span, &err)
+	defer errz.Recover(&err)
+
+	n, err := s.projects.UploadsExpire(ctx, time.Now())
+	errz.Fatal(err)
+
+	if n > 0 {
+		log.Ctx(ctx).Info().Int("uploads", n).Msg("Expired uploads removed.")
+	}
+
+	return nil
+}
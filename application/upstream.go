@@ -0,0 +1,194 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/benchkram/bobc/pkg/artifact"
+	"github.com/benchkram/bobc/pkg/logging"
+	"github.com/benchkram/bobc/pkg/projectrepo"
+	"github.com/benchkram/bobc/pkg/quota"
+	"github.com/benchkram/bobc/pkg/scope"
+	"github.com/benchkram/bobc/pkg/tracing"
+	"github.com/benchkram/bobc/pkg/upstream"
+	"github.com/benchkram/errz"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// maxUpstreamForwards limits the number of
+// uploads forwarded to the upstream in parallel.
+const maxUpstreamForwards = 4
+
+// fetchUpstream copies an artifact missing locally from the upstream, looking
+// into the scopes read by the request in order, and returns the scope it's
+// stored in, the scope it was found in upstream.
+// Concurrent requests for the same artifact share a single download.
+// Returns projectrepo.ErrNotFound if the upstream doesn't have it either.
+func (s *application) fetchUpstream(ctx context.Context, projectID uuid.UUID, sc, artifactID string) (_ string, err error) {
+	defer errz.Recover(&err)
+
+	// the download is shared, it must not be canceled with the request starting it
+	ctx = log.Ctx(ctx).WithContext(tracing.Detach(ctx))
+	ctx, cancel := s.upstreamTimeoutContext(ctx)
+	defer cancel()
+
+	key := strings.Join([]string{projectID.String(), sc, artifactID}, "/")
+	v, err, _ := s.upstreamFetches.Do(key, func() (interface{}, error) {
+		return s.fetchUpstreamOnce(ctx, projectID, sc, artifactID)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return v.(string), nil
+}
+
+func (s *application) fetchUpstreamOnce(ctx context.Context, projectID uuid.UUID, sc, artifactID string) (_ string, err error) {
+	ctx, span := tracing.Start(ctx, "application.fetchUpstream")
+	defer tracing.End(span, &err)
+	defer errz.Recover(&err)
+
+	scopes := scope.Chain(sc, s.scopeFallback)
+
+	// a request sharing the key might have completed in the meantime
+	exists, found, err := s.projects.ProjectArtifactExists(ctx, projectID, scopes, artifactID)
+	errz.Fatal(err)
+	if exists {
+		return found, nil
+	}
+
+	p, err := s.projects.Project(ctx, projectID)
+	errz.Fatal(err)
+
+	// the scopes are looked up one by one, as the upstream
+	// might not share the fallback scopes of this server
+	var a *artifact.A
+	var src io.ReadCloser
+	for _, sc := range scopes {
+		a, src, err = s.upstream.Artifact(ctx, p.Path(), sc, artifactID)
+		if errors.Is(err, upstream.ErrNotFound) {
+			continue
+		}
+		errz.Fatal(err)
+		break
+	}
+	if src == nil {
+		return "", projectrepo.ErrNotFound
+	}
+	defer src.Close()
+
+	// the upstream might read from a scope not read locally
+	exists, _, err = s.projects.ProjectArtifactExists(ctx, projectID, []string{a.Scope}, artifactID)
+	errz.Fatal(err)
+	if exists {
+		return a.Scope, nil
+	}
+
+	remaining, err := s.quotaRemaining(ctx, projectID, int64(a.Size), 1)
+	if errors.Is(err, ErrQuotaExceeded) {
+		log.Ctx(ctx).Warn().
+			Str(logging.FieldProjectID, projectID.String()).
+			Str(logging.FieldArtifactID, artifactID).
+			Msg("Artifact not fetched from upstream, quota exceeded.")
+		return "", projectrepo.ErrNotFound
+	}
+	errz.Fatal(err)
+
+	qr := quota.NewReader(src, remaining)
+	_, err = s.projects.CreateArtifact(ctx, projectID, a.Scope, artifactID, a.Digest, qr)
+	if qr.Exceeded() || errors.Is(err, projectrepo.ErrQuotaExceeded) {
+		log.Ctx(ctx).Warn().
+			Str(logging.FieldProjectID, projectID.String()).
+			Str(logging.FieldArtifactID, artifactID).
+			Msg("Artifact not fetched from upstream, quota exceeded.")
+		return "", projectrepo.ErrNotFound
+	}
+	errz.Fatal(err)
+
+	log.Ctx(ctx).Info().
+		Str(logging.FieldProjectID, projectID.String()).
+		Str(logging.FieldArtifactID, artifactID).
+		Msg("Artifact fetched from upstream.")
+
+	return a.Scope, nil
+}
+
+// forwardUpstream uploads an artifact created locally to the upstream in
+// the background. Failures are logged, the artifact stays available locally.
+func (s *application) forwardUpstream(ctx context.Context, projectID uuid.UUID, a *artifact.A) {
+	if s.upstream == nil || !s.upstreamForward {
+		return
+	}
+
+	ctx = log.Ctx(ctx).WithContext(tracing.Detach(ctx))
+	go func() {
+		s.upstreamForwards <- struct{}{}
+		defer func() { <-s.upstreamForwards }()
+
+		ctx, cancel := s.upstreamTimeoutContext(ctx)
+		defer cancel()
+
+		err := s.forward(ctx, projectID, a)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).
+				Str(logging.FieldProjectID, projectID.String()).
+				Str(logging.FieldArtifactID, a.ID).
+				Msg("Forwarding artifact to upstream failed.")
+			return
+		}
+
+		log.Ctx(ctx).Info().
+			Str(logging.FieldProjectID, projectID.String()).
+			Str(logging.FieldArtifactID, a.ID).
+			Msg("Artifact forwarded to upstream.")
+	}()
+}
+
+func (s *application) forward(ctx context.Context, projectID uuid.UUID, a *artifact.A) (err error) {
+	ctx, span := tracing.Start(ctx, "application.forwardUpstream")
+	defer tracing.End(span, &err)
+	defer errz.Recover(&err)
+
+	p, err := s.projects.Project(ctx, projectID)
+	errz.Fatal(err)
+
+	src, err := s.projects.ArtifactRead(ctx, projectID, a.Scope, a.ID)
+	errz.Fatal(err)
+	defer src.Close()
+
+	return s.upstream.ArtifactCreate(ctx, p.Path(), a.Scope, a.ID, a.Digest, src)
+}
+
+// upstreamTimeoutContext limits ctx to the upstream timeout, if any.
+func (s *application) upstreamTimeoutContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if s.upstreamTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, s.upstreamTimeout)
+}
+
+// existsUpstream looks up artifacts missing locally on the upstream in the
+// scope of the request. The artifacts present are mapped to the scope they
+// were found in, they are fetched once they are read.
+func (s *application) existsUpstream(ctx context.Context, projectID uuid.UUID, artifactIDs []string) (_ map[string]string, err error) {
+	ctx, span := tracing.Start(ctx, "application.existsUpstream")
+	defer tracing.End(span, &err)
+	defer errz.Recover(&err)
+
+	p, err := s.projects.Project(ctx, projectID)
+	errz.Fatal(err)
+
+	ctx, cancel := s.upstreamTimeoutContext(ctx)
+	defer cancel()
+
+	scopes, err := s.upstream.ArtifactsExist(ctx, p.Path(), scope.FromContext(ctx), artifactIDs)
+	if errors.Is(err, upstream.ErrNotFound) {
+		return map[string]string{}, nil
+	}
+	errz.Fatal(err)
+
+	return scopes, nil
+}
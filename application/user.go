@@ -0,0 +1,119 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"regexp"
+
+	"github.com/benchkram/bobc/pkg/logging"
+	"github.com/benchkram/bobc/pkg/orgrepo"
+	"github.com/benchkram/bobc/pkg/token"
+	"github.com/benchkram/bobc/pkg/tracing"
+	"github.com/benchkram/bobc/pkg/user"
+	"github.com/benchkram/errz"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// accountName matches valid names of users and organizations. They
+// start with an alphanumeric followed by alphanumerics, hyphens or
+// underscores and have a length of at most 39 characters.
+var accountName = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9-_]{0,38}$`)
+
+func (s *application) UserCreate(ctx context.Context, name string) (_ *user.U, err error) {
+	ctx, span := tracing.Start(ctx, "application.UserCreate")
+	defer tracing.End(span, &err)
+	defer errz.Recover(&err)
+
+	err = s.authorize(ctx, uuid.Nil, token.ScopeAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	if !accountName.MatchString(name) {
+		return nil, ErrInvalidUsername
+	}
+
+	u := user.New(name)
+	err = s.orgs.UserCreate(u)
+	if errors.Is(err, orgrepo.ErrAlreadyExists) {
+		return nil, ErrUserAlreadyExists
+	}
+	errz.Fatal(err)
+
+	log.Ctx(ctx).Info().
+		Str("user", u.Name).
+		Str(logging.FieldSubject, subject(ctx)).
+		Msg("User created.")
+
+	return u, nil
+}
+
+func (s *application) Users(ctx context.Context) (_ []*user.U, err error) {
+	ctx, span := tracing.Start(ctx, "application.Users")
+	defer tracing.End(span, &err)
+	defer errz.Recover(&err)
+
+	err = s.authorize(ctx, uuid.Nil, token.ScopeAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.orgs.Users()
+}
+
+func (s *application) UserByName(ctx context.Context, name string) (_ *user.U, err error) {
+	ctx, span := tracing.Start(ctx, "application.UserByName")
+	defer tracing.End(span, &err)
+	defer errz.Recover(&err)
+
+	err = s.authorize(ctx, uuid.Nil, token.ScopeAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.user(name)
+}
+
+// UserDelete deletes a user along with its memberships and api tokens.
+func (s *application) UserDelete(ctx context.Context, name string) (err error) {
+	ctx, span := tracing.Start(ctx, "application.UserDelete")
+	defer tracing.End(span, &err)
+	defer errz.Recover(&err)
+
+	err = s.authorize(ctx, uuid.Nil, token.ScopeAdmin)
+	if err != nil {
+		return err
+	}
+
+	u, err := s.user(name)
+	if err != nil {
+		return err
+	}
+
+	err = s.orgs.UserDelete(u.ID)
+	if errors.Is(err, orgrepo.ErrNotFound) {
+		return ErrUserNotFound
+	}
+	errz.Fatal(err)
+
+	log.Ctx(ctx).Info().
+		Str("user", u.Name).
+		Str(logging.FieldSubject, subject(ctx)).
+		Msg("User deleted.")
+
+	return nil
+}
+
+// user looks up a user by name without authorization.
+func (s *application) user(name string) (_ *user.U, err error) {
+	defer errz.Recover(&err)
+
+	u, err := s.orgs.UserByName(name)
+	if errors.Is(err, orgrepo.ErrNotFound) {
+		return nil, ErrUserNotFound
+	}
+	errz.Fatal(err)
+
+	return u, nil
+}
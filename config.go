@@ -2,12 +2,14 @@ package main
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/benchkram/bobc/pkg/db"
+	"github.com/benchkram/bobc/pkg/logging"
+	"github.com/benchkram/bobc/pkg/tracing"
 	"github.com/benchkram/bobc/restserver"
 	"github.com/fatih/structs"
-	"github.com/logrusorgru/aurora"
-	"github.com/sanity-io/litter"
+	"github.com/rs/zerolog/log"
 	"github.com/spf13/viper"
 )
 
@@ -23,6 +25,13 @@ func readGlobalConfig() {
 	if err != nil {
 		panic(err.Error())
 	}
+
+	err = logging.Setup(config.LogLevel, config.LogFormat)
+	if err != nil {
+		panic(err.Error())
+	}
+	logging.Redact(config.secrets()...)
+
 	config.Print()
 
 	// Set config object for main package
@@ -44,6 +53,8 @@ var defaultConfig = &config{
 	PostgresDBName:  postgresConfig.Name,
 	PostgresUseSSL:  postgresConfig.UseSSL,
 
+	SQLitePath: "./data/bobc.db",
+
 	DisableS3:         false,
 	S3Endpoint:        "localhost:9000",
 	S3AccessKeyID:     "minioadmin",
@@ -51,9 +62,47 @@ var defaultConfig = &config{
 	S3UseSSL:          false,
 	S3BucketName:      "artifacts",
 
-	UploadDir: restserver.DefaultUploadDir,
+	StorageDir:         "./data/artifacts",
+	PublicURL:          "http://localhost:8100",
+	DownloadSigningKey: "",
+
+	UploadDir:    restserver.DefaultUploadDir,
+	UploadExpiry: 24 * time.Hour,
+
+	ScopeFallback: []string{},
+
+	Upstream:        "",
+	UpstreamAPIKey:  "",
+	UpstreamForward: false,
+	UpstreamTimeout: 5 * time.Minute,
+
+	RetentionInterval: time.Hour,
+
+	GCInterval:    0,
+	GCApply:       false,
+	GCGracePeriod: time.Hour,
+
+	ReplicateTarget:   "",
+	ReplicateAPIKey:   "",
+	ReplicateProjects: []string{},
+	ReplicateInterval: 0,
+	ReplicateTimeout:  5 * time.Minute,
+
+	MetricsProjectUsage: false,
+
+	TracingExporter: tracing.ExporterNone,
+	TracingEndpoint: "localhost:4318",
+	TracingInsecure: false,
+
+	LogLevel:  "info",
+	LogFormat: logging.FormatConsole,
 
 	ApiKey: "",
+
+	OIDCIssuer:   "",
+	OIDCAudience: "",
+	OIDCJWKS:     "",
+	OIDCRules:    "",
 }
 
 func configInit() {
@@ -61,7 +110,7 @@ func configInit() {
 	rootCmd.PersistentFlags().String("hostname", "", "hostname to listen to")
 	rootCmd.PersistentFlags().String("port", "", "port to listen to")
 
-	rootCmd.PersistentFlags().Bool("disable-pg", false, "disable postgres usage only for landing page view")
+	rootCmd.PersistentFlags().Bool("disable-pg", false, "use an embedded sqlite database instead of postgres")
 	rootCmd.PersistentFlags().String("pg-host", defaultConfig.PostgresHost, "hostname to connect with postgres database")
 	rootCmd.PersistentFlags().String("pg-port", defaultConfig.PostgresPort, "port to connect with postgres database")
 	rootCmd.PersistentFlags().String("pg-user", defaultConfig.PostgresUser, "username for the postgres database")
@@ -69,17 +118,57 @@ func configInit() {
 	rootCmd.PersistentFlags().String("pg-db-name", defaultConfig.PostgresDBName, "database name on postgres database")
 	rootCmd.PersistentFlags().Bool("pg-use-ssl", defaultConfig.PostgresUseSSL, "whether to use SSL when connecting to postgres")
 
-	rootCmd.PersistentFlags().Bool("disable-s3", defaultConfig.DisableS3, "disable s3 client")
+	rootCmd.PersistentFlags().String("sqlite-path", defaultConfig.SQLitePath, "path of the sqlite database file when postgres is disabled")
+
+	rootCmd.PersistentFlags().Bool("disable-s3", defaultConfig.DisableS3, "disable s3 client and store artifacts on the local filesystem")
 	rootCmd.PersistentFlags().String("s3-endpoint", defaultConfig.S3Endpoint, "s3 endpoint")
 	rootCmd.PersistentFlags().String("s3-access-key-id", defaultConfig.S3AccessKeyID, "s3 access key id")
 	rootCmd.PersistentFlags().String("s3-secret-access-key", defaultConfig.S3SecretAccessKey, "s3 secret access key")
 	rootCmd.PersistentFlags().Bool("s3-use-ssl", defaultConfig.S3UseSSL, "s3 use ssl")
 	rootCmd.PersistentFlags().String("s3-bucket-name", defaultConfig.S3BucketName, "s3 bucket name")
 
+	rootCmd.PersistentFlags().String("storage-dir", defaultConfig.StorageDir, "directory to store artifacts in when s3 is disabled")
+	rootCmd.PersistentFlags().String("public-url", defaultConfig.PublicURL, "public address of the server, used for download links when s3 is disabled")
+	rootCmd.PersistentFlags().String("download-signing-key", defaultConfig.DownloadSigningKey, "key to sign download links when s3 is disabled, random if empty")
+
 	rootCmd.PersistentFlags().String("upload-dir", defaultConfig.UploadDir, "Upload directory on system to upload hash files")
+	rootCmd.PersistentFlags().Duration("upload-expiry", defaultConfig.UploadExpiry, "time span after which incomplete resumable uploads are discarded")
+
+	rootCmd.PersistentFlags().StringSlice("scope-fallback", defaultConfig.ScopeFallback, "scopes artifacts are read from when missing in the scope of a request, e.g. main")
+
+	rootCmd.PersistentFlags().String("upstream", defaultConfig.Upstream, "address of a bobc server artifacts missing locally are fetched from, disabled if empty")
+	rootCmd.PersistentFlags().String("upstream-api-key", defaultConfig.UpstreamAPIKey, "api key or token to authenticate at the upstream")
+	rootCmd.PersistentFlags().Bool("upstream-forward", defaultConfig.UpstreamForward, "forward uploads to the upstream in the background")
+	rootCmd.PersistentFlags().Duration("upstream-timeout", defaultConfig.UpstreamTimeout, "time a request to the upstream, including the transfer of an artifact, may take, unlimited if 0")
+
+	rootCmd.PersistentFlags().Duration("retention-interval", defaultConfig.RetentionInterval, "interval to evict artifacts according to the retention policies, disabled if 0")
+
+	rootCmd.PersistentFlags().Duration("gc-interval", defaultConfig.GCInterval, "interval to run the garbage collector in the server, disabled if 0")
+	rootCmd.PersistentFlags().Bool("gc-apply", defaultConfig.GCApply, "let the garbage collector in the server remove what it finds instead of only reporting it")
+	rootCmd.PersistentFlags().Duration("gc-grace-period", defaultConfig.GCGracePeriod, "minimum age of an object before the garbage collector considers it orphaned")
+
+	rootCmd.PersistentFlags().String("replicate-target", defaultConfig.ReplicateTarget, "address of a bobc server to replicate projects to")
+	rootCmd.PersistentFlags().String("replicate-api-key", defaultConfig.ReplicateAPIKey, "api key or token to authenticate at the replication target")
+	rootCmd.PersistentFlags().StringSlice("replicate-projects", defaultConfig.ReplicateProjects, "paths of the projects to replicate, all if empty")
+	rootCmd.PersistentFlags().Duration("replicate-interval", defaultConfig.ReplicateInterval, "interval to replicate projects in the server, disabled if 0")
+	rootCmd.PersistentFlags().Duration("replicate-timeout", defaultConfig.ReplicateTimeout, "time a request to the replication target, including the transfer of an artifact, may take, unlimited if 0")
+
+	rootCmd.PersistentFlags().Bool("metrics-project-usage", defaultConfig.MetricsProjectUsage, "report the storage used by each project on /metrics, labeled with the project path")
+
+	rootCmd.PersistentFlags().String("tracing-exporter", defaultConfig.TracingExporter, "exporter to send traces to, one of none, stdout or otlp")
+	rootCmd.PersistentFlags().String("tracing-endpoint", defaultConfig.TracingEndpoint, "host:port of the OTLP/HTTP collector used by the otlp exporter")
+	rootCmd.PersistentFlags().Bool("tracing-insecure", defaultConfig.TracingInsecure, "connect to the OTLP/HTTP collector without TLS")
+
+	rootCmd.PersistentFlags().String("log-level", defaultConfig.LogLevel, "minimum level of log lines, one of trace, debug, info, warn or error")
+	rootCmd.PersistentFlags().String("log-format", defaultConfig.LogFormat, "format of log lines, console or json")
 
 	rootCmd.PersistentFlags().String("api-key", defaultConfig.ApiKey, "API key to check against when authenticating against the http server")
 
+	rootCmd.PersistentFlags().String("oidc-issuer", defaultConfig.OIDCIssuer, "issuer of JWTs accepted for authentication, disabled if empty")
+	rootCmd.PersistentFlags().String("oidc-audience", defaultConfig.OIDCAudience, "audience JWTs must be issued for")
+	rootCmd.PersistentFlags().String("oidc-jwks", defaultConfig.OIDCJWKS, "file or url of the key set to verify JWTs")
+	rootCmd.PersistentFlags().String("oidc-rules", defaultConfig.OIDCRules, "file with rules mapping JWT claims to project permissions")
+
 	// CLI PARAMETERS
 	_ = viper.BindPFlag("host", rootCmd.PersistentFlags().Lookup("host"))
 	_ = viper.BindPFlag("port", rootCmd.PersistentFlags().Lookup("port"))
@@ -92,6 +181,8 @@ func configInit() {
 	_ = viper.BindPFlag("pg-db-name", rootCmd.PersistentFlags().Lookup("pg-db-name"))
 	_ = viper.BindPFlag("pg-use-ssl", rootCmd.PersistentFlags().Lookup("pg-use-ssl"))
 
+	_ = viper.BindPFlag("sqlite-path", rootCmd.PersistentFlags().Lookup("sqlite-path"))
+
 	_ = viper.BindPFlag("disable-s3", rootCmd.PersistentFlags().Lookup("disable-s3"))
 	_ = viper.BindPFlag("s3-endpoint", rootCmd.PersistentFlags().Lookup("s3-endpoint"))
 	_ = viper.BindPFlag("s3-access-key-id", rootCmd.PersistentFlags().Lookup("s3-access-key-id"))
@@ -99,14 +190,52 @@ func configInit() {
 	_ = viper.BindPFlag("s3-use-ssl", rootCmd.PersistentFlags().Lookup("s3-use-ssl"))
 	_ = viper.BindPFlag("s3-bucket-name", rootCmd.PersistentFlags().Lookup("s3-bucket-name"))
 
+	_ = viper.BindPFlag("storage-dir", rootCmd.PersistentFlags().Lookup("storage-dir"))
+	_ = viper.BindPFlag("public-url", rootCmd.PersistentFlags().Lookup("public-url"))
+	_ = viper.BindPFlag("download-signing-key", rootCmd.PersistentFlags().Lookup("download-signing-key"))
+
 	_ = viper.BindPFlag("keto-read-endpoint", rootCmd.PersistentFlags().Lookup("keto-read-endpoint"))
 	_ = viper.BindPFlag("keto-write-endpoint", rootCmd.PersistentFlags().Lookup("keto-write-endpoint"))
 	_ = viper.BindPFlag("keto-default-namespace", rootCmd.PersistentFlags().Lookup("keto-default-namespace"))
 
 	_ = viper.BindPFlag("upload-dir", rootCmd.PersistentFlags().Lookup("upload-dir"))
+	_ = viper.BindPFlag("upload-expiry", rootCmd.PersistentFlags().Lookup("upload-expiry"))
+
+	_ = viper.BindPFlag("scope-fallback", rootCmd.PersistentFlags().Lookup("scope-fallback"))
+
+	_ = viper.BindPFlag("upstream", rootCmd.PersistentFlags().Lookup("upstream"))
+	_ = viper.BindPFlag("upstream-api-key", rootCmd.PersistentFlags().Lookup("upstream-api-key"))
+	_ = viper.BindPFlag("upstream-forward", rootCmd.PersistentFlags().Lookup("upstream-forward"))
+	_ = viper.BindPFlag("upstream-timeout", rootCmd.PersistentFlags().Lookup("upstream-timeout"))
+
+	_ = viper.BindPFlag("retention-interval", rootCmd.PersistentFlags().Lookup("retention-interval"))
+
+	_ = viper.BindPFlag("gc-interval", rootCmd.PersistentFlags().Lookup("gc-interval"))
+	_ = viper.BindPFlag("gc-apply", rootCmd.PersistentFlags().Lookup("gc-apply"))
+	_ = viper.BindPFlag("gc-grace-period", rootCmd.PersistentFlags().Lookup("gc-grace-period"))
+
+	_ = viper.BindPFlag("replicate-target", rootCmd.PersistentFlags().Lookup("replicate-target"))
+	_ = viper.BindPFlag("replicate-api-key", rootCmd.PersistentFlags().Lookup("replicate-api-key"))
+	_ = viper.BindPFlag("replicate-projects", rootCmd.PersistentFlags().Lookup("replicate-projects"))
+	_ = viper.BindPFlag("replicate-interval", rootCmd.PersistentFlags().Lookup("replicate-interval"))
+	_ = viper.BindPFlag("replicate-timeout", rootCmd.PersistentFlags().Lookup("replicate-timeout"))
+
+	_ = viper.BindPFlag("metrics-project-usage", rootCmd.PersistentFlags().Lookup("metrics-project-usage"))
+
+	_ = viper.BindPFlag("tracing-exporter", rootCmd.PersistentFlags().Lookup("tracing-exporter"))
+	_ = viper.BindPFlag("tracing-endpoint", rootCmd.PersistentFlags().Lookup("tracing-endpoint"))
+	_ = viper.BindPFlag("tracing-insecure", rootCmd.PersistentFlags().Lookup("tracing-insecure"))
+
+	_ = viper.BindPFlag("log-level", rootCmd.PersistentFlags().Lookup("log-level"))
+	_ = viper.BindPFlag("log-format", rootCmd.PersistentFlags().Lookup("log-format"))
 
 	_ = viper.BindPFlag("api-key", rootCmd.PersistentFlags().Lookup("api-key"))
 
+	_ = viper.BindPFlag("oidc-issuer", rootCmd.PersistentFlags().Lookup("oidc-issuer"))
+	_ = viper.BindPFlag("oidc-audience", rootCmd.PersistentFlags().Lookup("oidc-audience"))
+	_ = viper.BindPFlag("oidc-jwks", rootCmd.PersistentFlags().Lookup("oidc-jwks"))
+	_ = viper.BindPFlag("oidc-rules", rootCmd.PersistentFlags().Lookup("oidc-rules"))
+
 	// ENVIRONMENT VARS
 	_ = viper.BindEnv("hostname", "HOSTNAME")
 	_ = viper.BindEnv("port", "PORT")
@@ -119,6 +248,8 @@ func configInit() {
 	_ = viper.BindEnv("pg-db-name", "POSTGRES_DB_NAME")
 	_ = viper.BindEnv("pg-use-ssl", "POSTGRES_USE_SSL")
 
+	_ = viper.BindEnv("sqlite-path", "SQLITE_PATH")
+
 	_ = viper.BindEnv("disable-s3", "DISABLE_S3")
 	_ = viper.BindEnv("s3-endpoint", "S3_ENDPOINT")
 	_ = viper.BindEnv("s3-access-key-id", "S3_ACCESS_KEY_ID")
@@ -126,13 +257,51 @@ func configInit() {
 	_ = viper.BindEnv("s3-use-ssl", "S3_USE_SSL")
 	_ = viper.BindEnv("s3-bucket-name", "S3_BUCKET_NAME")
 
+	_ = viper.BindEnv("storage-dir", "STORAGE_DIR")
+	_ = viper.BindEnv("public-url", "PUBLIC_URL")
+	_ = viper.BindEnv("download-signing-key", "DOWNLOAD_SIGNING_KEY")
+
 	_ = viper.BindEnv("keto-read-endpoint", "KETO_READ_ENDPOINT")
 	_ = viper.BindEnv("keto-write-endpoint", "KETO_WRITE_ENDPOINT")
 	_ = viper.BindEnv("keto-default-namespace", "KETO_DEFAULT_NAMESPACE")
 
 	_ = viper.BindEnv("upload-dir", "UPLOAD_DIRECTORY")
+	_ = viper.BindEnv("upload-expiry", "UPLOAD_EXPIRY")
+
+	_ = viper.BindEnv("scope-fallback", "SCOPE_FALLBACK")
+
+	_ = viper.BindEnv("upstream", "UPSTREAM")
+	_ = viper.BindEnv("upstream-api-key", "UPSTREAM_API_KEY")
+	_ = viper.BindEnv("upstream-forward", "UPSTREAM_FORWARD")
+	_ = viper.BindEnv("upstream-timeout", "UPSTREAM_TIMEOUT")
+
+	_ = viper.BindEnv("retention-interval", "RETENTION_INTERVAL")
+
+	_ = viper.BindEnv("gc-interval", "GC_INTERVAL")
+	_ = viper.BindEnv("gc-apply", "GC_APPLY")
+	_ = viper.BindEnv("gc-grace-period", "GC_GRACE_PERIOD")
+
+	_ = viper.BindEnv("replicate-target", "REPLICATE_TARGET")
+	_ = viper.BindEnv("replicate-api-key", "REPLICATE_API_KEY")
+	_ = viper.BindEnv("replicate-projects", "REPLICATE_PROJECTS")
+	_ = viper.BindEnv("replicate-interval", "REPLICATE_INTERVAL")
+	_ = viper.BindEnv("replicate-timeout", "REPLICATE_TIMEOUT")
+
+	_ = viper.BindEnv("metrics-project-usage", "METRICS_PROJECT_USAGE")
+
+	_ = viper.BindEnv("tracing-exporter", "TRACING_EXPORTER")
+	_ = viper.BindEnv("tracing-endpoint", "TRACING_ENDPOINT")
+	_ = viper.BindEnv("tracing-insecure", "TRACING_INSECURE")
+
+	_ = viper.BindEnv("log-level", "LOG_LEVEL")
+	_ = viper.BindEnv("log-format", "LOG_FORMAT")
 
 	_ = viper.BindEnv("api-key", "API_KEY")
+
+	_ = viper.BindEnv("oidc-issuer", "OIDC_ISSUER")
+	_ = viper.BindEnv("oidc-audience", "OIDC_AUDIENCE")
+	_ = viper.BindEnv("oidc-jwks", "OIDC_JWKS")
+	_ = viper.BindEnv("oidc-rules", "OIDC_RULES")
 }
 
 // Create private data struct to hold config options.
@@ -152,6 +321,9 @@ type config struct {
 	PostgresDBName  string `mapstructure:"pg-db-name" structs:"pg-db-name"`
 	PostgresUseSSL  bool   `mapstructure:"pg-use-ssl" structs:"pg-use-ssl"`
 
+	// SQLite, used when postgres is disabled
+	SQLitePath string `mapstructure:"sqlite-path" structs:"sqlite-path"`
+
 	// Object store
 	DisableS3         bool   `mapstructure:"disable-s3" structs:"disable-s3"`
 	S3Endpoint        string `mapstructure:"s3-endpoint" structs:"s3-endpoint"`
@@ -160,19 +332,98 @@ type config struct {
 	S3UseSSL          bool   `mapstructure:"s3-use-ssl" structs:"s3-use-ssl"`
 	S3BucketName      string `mapstructure:"s3-bucket-name" structs:"s3-bucket-name"`
 
+	// Local storage, used when s3 is disabled
+	StorageDir         string `mapstructure:"storage-dir" structs:"storage-dir"`
+	PublicURL          string `mapstructure:"public-url" structs:"public-url"`
+	DownloadSigningKey string `mapstructure:"download-signing-key" structs:"download-signing-key"`
+
 	// Upload
-	UploadDir string `mapstructure:"upload-dir" structs:"upload-dir"`
+	UploadDir    string        `mapstructure:"upload-dir" structs:"upload-dir"`
+	UploadExpiry time.Duration `mapstructure:"upload-expiry" structs:"upload-expiry"`
+
+	// Scopes
+	ScopeFallback []string `mapstructure:"scope-fallback" structs:"scope-fallback"`
+
+	// Upstream
+	Upstream        string        `mapstructure:"upstream" structs:"upstream"`
+	UpstreamAPIKey  string        `mapstructure:"upstream-api-key" structs:"upstream-api-key"`
+	UpstreamForward bool          `mapstructure:"upstream-forward" structs:"upstream-forward"`
+	UpstreamTimeout time.Duration `mapstructure:"upstream-timeout" structs:"upstream-timeout"`
+
+	// Retention
+	RetentionInterval time.Duration `mapstructure:"retention-interval" structs:"retention-interval"`
+
+	// Garbage collection
+	GCInterval    time.Duration `mapstructure:"gc-interval" structs:"gc-interval"`
+	GCApply       bool          `mapstructure:"gc-apply" structs:"gc-apply"`
+	GCGracePeriod time.Duration `mapstructure:"gc-grace-period" structs:"gc-grace-period"`
+
+	// Replication
+	ReplicateTarget   string        `mapstructure:"replicate-target" structs:"replicate-target"`
+	ReplicateAPIKey   string        `mapstructure:"replicate-api-key" structs:"replicate-api-key"`
+	ReplicateProjects []string      `mapstructure:"replicate-projects" structs:"replicate-projects"`
+	ReplicateInterval time.Duration `mapstructure:"replicate-interval" structs:"replicate-interval"`
+	ReplicateTimeout  time.Duration `mapstructure:"replicate-timeout" structs:"replicate-timeout"`
+
+	// Metrics
+	MetricsProjectUsage bool `mapstructure:"metrics-project-usage" structs:"metrics-project-usage"`
+
+	// Tracing
+	TracingExporter string `mapstructure:"tracing-exporter" structs:"tracing-exporter"`
+	TracingEndpoint string `mapstructure:"tracing-endpoint" structs:"tracing-endpoint"`
+	TracingInsecure bool   `mapstructure:"tracing-insecure" structs:"tracing-insecure"`
+
+	// Logging
+	LogLevel  string `mapstructure:"log-level" structs:"log-level"`
+	LogFormat string `mapstructure:"log-format" structs:"log-format"`
 
 	// authentication
 	ApiKey string `mapstructure:"api-key" structs:"api-key"`
+
+	// OIDC, used when an issuer is set
+	OIDCIssuer   string `mapstructure:"oidc-issuer" structs:"oidc-issuer"`
+	OIDCAudience string `mapstructure:"oidc-audience" structs:"oidc-audience"`
+	OIDCJWKS     string `mapstructure:"oidc-jwks" structs:"oidc-jwks"`
+	OIDCRules    string `mapstructure:"oidc-rules" structs:"oidc-rules"`
 }
 
 func (c *config) AsMap() map[string]interface{} {
 	return structs.Map(c)
 }
 
+// secretKeys are the options which must not appear in logs.
+var secretKeys = []string{
+	"pg-pass",
+	"s3-secret-access-key",
+	"download-signing-key",
+	"upstream-api-key",
+	"replicate-api-key",
+	"api-key",
+}
+
+// secrets returns the values of the secret options. Defaults are public
+// anyway and redacting them would garble lines containing e.g. "postgres".
+func (c *config) secrets() []string {
+	m := c.AsMap()
+	defaults := defaultConfig.AsMap()
+
+	secrets := []string{}
+	for _, key := range secretKeys {
+		if v, ok := m[key].(string); ok && v != defaults[key] {
+			secrets = append(secrets, v)
+		}
+	}
+	return secrets
+}
+
 func (c *config) Print() {
-	litter.Dump(c)
+	m := c.AsMap()
+	for _, key := range secretKeys {
+		if m[key] != "" {
+			m[key] = logging.Redacted
+		}
+	}
+	log.Info().Fields(m).Msg("Configuration loaded.")
 }
 
 // readConfig a helper to read default from a default config object.
@@ -190,7 +441,7 @@ func readConfig(defaults map[string]interface{}) (*config, error) {
 	if err != nil {
 		switch err.(type) {
 		case viper.ConfigFileNotFoundError:
-			fmt.Printf("%s\n", aurora.Yellow("Could not find a config file"))
+			log.Warn().Msg("Could not find a config file.")
 		default:
 			return nil, fmt.Errorf("config file invalid: %s \n", err)
 		}
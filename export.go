@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/benchkram/bobc/pkg/principal"
+	"github.com/benchkram/errz"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	exportCmd.Flags().StringP("output", "o", "", "file to write the archive to, defaults to <organization>_<project>.tar.gz")
+	rootCmd.AddCommand(exportCmd)
+}
+
+var exportCmd = &cobra.Command{
+	Use:   "export <project>",
+	Short: "export a project as archive",
+	Long: `Writes a gzip compressed tar archive holding the project's metadata,
+a manifest of its artifacts and their payloads. It can be imported
+into another bobc installation with bobc import.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		output, err := cmd.Flags().GetString("output")
+		errz.Fatal(err)
+
+		export(args[0], output)
+	},
+}
+
+func export(projectName, output string) {
+	app, _, err := newApplication()
+	errz.Fatal(err)
+
+	ctx := principal.NewContext(context.Background(), principal.Admin("cli"))
+
+	id, err := app.ProjectIDByName(ctx, projectName)
+	errz.Fatal(err)
+
+	p, err := app.Project(ctx, id)
+	errz.Fatal(err)
+
+	if output == "" {
+		output = p.Organization + "_" + p.Name + ".tar.gz"
+	}
+
+	f, err := os.Create(output)
+	errz.Fatal(err)
+	defer f.Close()
+
+	err = app.ProjectExport(ctx, id, f)
+	errz.Fatal(err)
+
+	err = f.Close()
+	errz.Fatal(err)
+
+	fmt.Printf("exported %s to %s\n", p.Path(), output)
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"os"
+
+	"github.com/benchkram/errz"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	gcCmd.Flags().Bool("apply", false, "remove the inconsistencies found instead of only reporting them")
+	rootCmd.AddCommand(gcCmd)
+}
+
+var gcCmd = &cobra.Command{
+	Use:   "gc",
+	Short: "reconcile the database with the artifact store",
+	Long: `Finds objects in the artifact store which don't belong to any artifact
+and artifacts whose object is missing. They are only reported unless --apply is set.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		apply, err := cmd.Flags().GetBool("apply")
+		errz.Fatal(err)
+
+		gc(apply)
+	},
+}
+
+func gc(apply bool) {
+	app, _, err := newApplication()
+	errz.Fatal(err)
+
+	report, err := app.GarbageCollect(apply)
+	errz.Fatal(err)
+
+	report.Print(os.Stdout)
+}
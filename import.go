@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/benchkram/bobc/pkg/principal"
+	"github.com/benchkram/errz"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	importCmd.Flags().String("project", "", "path of the project to import to, defaults to the path stored in the archive")
+	rootCmd.AddCommand(importCmd)
+}
+
+var importCmd = &cobra.Command{
+	Use:   "import <archive>",
+	Short: "import a project from an archive",
+	Long: `Reads an archive written by bobc export, "-" reads from stdin. The project
+and its organization are created if missing, artifacts which already exist
+are skipped.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		project, err := cmd.Flags().GetString("project")
+		errz.Fatal(err)
+
+		importArchive(args[0], project)
+	},
+}
+
+func importArchive(file, projectPath string) {
+	app, _, err := newApplication()
+	errz.Fatal(err)
+
+	ctx := principal.NewContext(context.Background(), principal.Admin("cli"))
+
+	var r io.Reader = os.Stdin
+	if file != "-" {
+		f, err := os.Open(file)
+		errz.Fatal(err)
+		defer f.Close()
+		r = f
+	}
+
+	report, err := app.ProjectImport(ctx, r, projectPath)
+	errz.Fatal(err)
+
+	fmt.Printf("imported %d artifacts into %s, skipped %d existing\n", report.Imported, report.Project, report.Skipped)
+}
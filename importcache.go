@@ -0,0 +1,61 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/benchkram/bobc/pkg/cacheimport"
+	restserverclient "github.com/benchkram/bobc/rest-server-client"
+	"github.com/benchkram/errz"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	importCacheCmd.Flags().String("project", "", "path of the project to upload to, e.g. org/name")
+	importCacheCmd.Flags().String("server", "localhost:8100", "address of the bobc server")
+	importCacheCmd.Flags().String("token", "", "api key used for the server")
+	importCacheCmd.Flags().String("scope", "", "scope to upload the artifacts to, e.g. a branch")
+	importCacheCmd.Flags().Int("concurrency", 4, "number of parallel uploads")
+	rootCmd.AddCommand(importCacheCmd)
+}
+
+var importCacheCmd = &cobra.Command{
+	Use:   "import-cache --project <project> <dir>",
+	Short: "upload a local bob artifact cache to a project",
+	Long: `Walks a bob artifact cache directory and uploads its artifacts to a project
+on a bobc server. The file names are used as artifact ids, artifacts which
+already exist in the project are skipped.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		project, err := cmd.Flags().GetString("project")
+		errz.Fatal(err)
+		server, err := cmd.Flags().GetString("server")
+		errz.Fatal(err)
+		token, err := cmd.Flags().GetString("token")
+		errz.Fatal(err)
+		scope, err := cmd.Flags().GetString("scope")
+		errz.Fatal(err)
+		concurrency, err := cmd.Flags().GetInt("concurrency")
+		errz.Fatal(err)
+
+		importCache(args[0], project, server, token, scope, concurrency)
+	},
+}
+
+func importCache(dir, project, server, token, scope string, concurrency int) {
+	if project == "" {
+		errz.Fatal(fmt.Errorf("--project is required"))
+	}
+
+	c, err := restserverclient.New(server, []byte(token), restserverclient.WithScope(scope))
+	errz.Fatal(err)
+
+	report, err := cacheimport.Import(c, project, dir, concurrency)
+	errz.Fatal(err)
+
+	fmt.Println(report.Summary())
+
+	if report.Failed > 0 {
+		os.Exit(1)
+	}
+}
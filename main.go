@@ -3,21 +3,30 @@ package main
 import (
 	"context"
 	"fmt"
-	"log"
+	"net/url"
 	"os"
+	"time"
 
 	"github.com/benchkram/bobc/application"
 	"github.com/benchkram/bobc/pkg/artifactstore"
+	"github.com/benchkram/bobc/pkg/localstore"
+	"github.com/benchkram/bobc/pkg/metrics"
+	"github.com/benchkram/bobc/pkg/oidc"
+	"github.com/benchkram/bobc/pkg/orgrepo"
+	"github.com/benchkram/bobc/pkg/periodic"
 	"github.com/benchkram/bobc/pkg/projectrepo"
+	"github.com/benchkram/bobc/pkg/tokenrepo"
+	"github.com/benchkram/bobc/pkg/tracing"
+	"github.com/benchkram/bobc/pkg/upstream"
 	"github.com/benchkram/bobc/restserver"
 
 	database "github.com/benchkram/bobc/pkg/db"
 	"github.com/benchkram/bobc/pkg/wait"
 	"github.com/benchkram/bobc/restserver/authenticator"
 	"github.com/benchkram/errz"
-	"github.com/logrusorgru/aurora"
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
 )
 
@@ -45,64 +54,74 @@ var rootCmd = &cobra.Command{
 }
 
 func start() {
-	fmt.Printf("\n  %s\n\n", aurora.Green("Starting bob-server"))
+	log.Info().Msg("Starting bob-server.")
 
-	minioClient, err := minio.New(GlobalConfig.S3Endpoint, &minio.Options{
-		Creds:  credentials.NewStaticV4(GlobalConfig.S3AccessKeyID, GlobalConfig.S3SecretAccessKey, ""),
-		Secure: GlobalConfig.S3UseSSL,
-	})
+	shutdownTracing, err := tracing.Setup(
+		GlobalConfig.TracingExporter,
+		GlobalConfig.TracingEndpoint,
+		GlobalConfig.TracingInsecure,
+	)
 	errz.Fatal(err)
 
-	err = minioClient.MakeBucket(context.Background(), GlobalConfig.S3BucketName, minio.MakeBucketOptions{})
-	if err != nil {
-		// Check to see if we already own this bucket (which happens if you run this twice)
-		exists, errBucketExists := minioClient.BucketExists(context.Background(), GlobalConfig.S3BucketName)
-		if errBucketExists == nil && exists {
-			log.Printf("We already own bucket %s\n", GlobalConfig.S3BucketName)
-		} else {
-			errz.Fatal(err)
-		}
-	} else {
-		log.Printf("Successfully created bucket %s\n", GlobalConfig.S3BucketName)
+	app, downloader, err := newApplication()
+	errz.Fatal(err)
+
+	// the gauges are labeled with project paths, which may be confidential
+	if GlobalConfig.MetricsProjectUsage {
+		metrics.RegisterUsage(app.ProjectsUsage)
 	}
 
-	artifactStore := artifactstore.New(
-		minioClient,
-		artifactstore.WithBucketName(GlobalConfig.S3BucketName),
-	)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 
-	// create database
-	db := database.New(
-		database.WithPostgres(
-			GlobalConfig.PostgresHost,
-			GlobalConfig.PostgresPort,
-			GlobalConfig.PostgresUser,
-			GlobalConfig.PostgresPass,
-			GlobalConfig.PostgresDBName,
-			GlobalConfig.PostgresUseSSL,
-		),
-	)
+	// discard resumable uploads which have not been completed in time
+	go periodic.Run(ctx, time.Hour, app.UploadsExpire)
 
-	err = db.Connect()
-	errz.Fatal(err)
+	if GlobalConfig.RetentionInterval > 0 {
+		go periodic.Run(ctx, GlobalConfig.RetentionInterval, app.RetentionEnforce)
+	}
 
-	projectRepo := projectrepo.New(db, artifactStore)
+	if GlobalConfig.GCInterval > 0 {
+		go periodic.Run(ctx, GlobalConfig.GCInterval, func() error {
+			_, err := app.GarbageCollect(GlobalConfig.GCApply)
+			return err
+		})
+	}
 
-	app := application.New(
-		application.WithProjectRepository(projectRepo),
-	)
+	if GlobalConfig.ReplicateInterval > 0 && GlobalConfig.ReplicateTarget != "" {
+		target := upstream.New(GlobalConfig.ReplicateTarget, []byte(GlobalConfig.ReplicateAPIKey),
+			upstream.WithTimeout(GlobalConfig.ReplicateTimeout),
+		)
+		go periodic.Run(ctx, GlobalConfig.ReplicateInterval, func() error {
+			_, err := app.Replicate(target, GlobalConfig.ReplicateProjects)
+			return err
+		})
+	}
 
 	restOpts := []restserver.Option{
 		restserver.WithArtifactService(app),
 		restserver.WithHost(GlobalConfig.Hostname, GlobalConfig.Port),
 		restserver.WithUploadDir(GlobalConfig.UploadDir),
+		restserver.WithDownloader(downloader),
 	}
 
 	restOpts = append(restOpts, restserver.WithHost(GlobalConfig.Hostname, GlobalConfig.Port))
 	restOpts = append(restOpts, restserver.WithUploadDir(GlobalConfig.UploadDir))
 
 	// create rest-server
-	authn := authenticator.New([]byte(GlobalConfig.ApiKey))
+	authnOpts := []authenticator.Option{
+		authenticator.WithTokens(app),
+	}
+	if GlobalConfig.OIDCIssuer != "" {
+		verifier, err := newOIDCVerifier(app)
+		errz.Fatal(err)
+		authnOpts = append(authnOpts, authenticator.WithOIDC(verifier))
+	}
+
+	authn := authenticator.New(
+		[]byte(GlobalConfig.ApiKey),
+		authnOpts...,
+	)
 	restOpts = append(restOpts, restserver.WithAuthenticator(authn))
 
 	server, err := restserver.New(
@@ -118,4 +137,140 @@ func start() {
 
 	err = server.Stop()
 	errz.Fatal(err)
+
+	err = shutdownTracing(context.Background())
+	errz.Fatal(err)
+}
+
+// newApplication wires the application with its database and artifact store.
+// The downloader is only set when bobc serves downloads itself.
+func newApplication() (_ application.Application, _ restserver.Downloader, err error) {
+	defer errz.Recover(&err)
+
+	artifactStore, downloader, err := newArtifactStore()
+	errz.Fatal(err)
+
+	db, err := newDatabase()
+	errz.Fatal(err)
+
+	projectRepo := projectrepo.New(db, artifactStore)
+	tokenRepo := tokenrepo.New(db)
+	orgRepo := orgrepo.New(db)
+
+	var up application.Upstream
+	if GlobalConfig.Upstream != "" {
+		up = upstream.New(GlobalConfig.Upstream, []byte(GlobalConfig.UpstreamAPIKey),
+			upstream.WithTimeout(GlobalConfig.UpstreamTimeout),
+		)
+	}
+
+	app := application.New(
+		application.WithProjectRepository(projectRepo),
+		application.WithTokenRepository(tokenRepo),
+		application.WithOrganizationRepository(orgRepo),
+		application.WithUploadExpiry(GlobalConfig.UploadExpiry),
+		application.WithGCGracePeriod(GlobalConfig.GCGracePeriod),
+		application.WithScopeFallback(GlobalConfig.ScopeFallback),
+		application.WithUpstream(up),
+		application.WithUpstreamForward(GlobalConfig.UpstreamForward),
+		application.WithUpstreamTimeout(GlobalConfig.UpstreamTimeout),
+	)
+
+	return app, downloader, nil
+}
+
+// newOIDCVerifier loads the key set and rules to accept JWTs
+// of the configured OIDC provider.
+func newOIDCVerifier(projects oidc.Projects) (_ *oidc.Verifier, err error) {
+	defer errz.Recover(&err)
+
+	if GlobalConfig.OIDCAudience == "" || GlobalConfig.OIDCJWKS == "" || GlobalConfig.OIDCRules == "" {
+		errz.Fatal(fmt.Errorf("oidc requires an audience, a key set and rules"))
+	}
+
+	keys, err := oidc.NewKeySet(GlobalConfig.OIDCJWKS)
+	errz.Fatal(err)
+
+	rules, err := oidc.LoadRules(GlobalConfig.OIDCRules)
+	errz.Fatal(err)
+
+	log.Info().Str("issuer", GlobalConfig.OIDCIssuer).Int("rules", len(rules)).Msg("Accepting JWTs.")
+
+	return oidc.New(GlobalConfig.OIDCIssuer, GlobalConfig.OIDCAudience, keys, rules, projects), nil
+}
+
+// newDatabase connects to the database holding projects and artifact metadata.
+// An embedded sqlite database is used when postgres is disabled.
+func newDatabase() (_ database.Database, err error) {
+	defer errz.Recover(&err)
+
+	opt := database.WithPostgres(
+		GlobalConfig.PostgresHost,
+		GlobalConfig.PostgresPort,
+		GlobalConfig.PostgresUser,
+		GlobalConfig.PostgresPass,
+		GlobalConfig.PostgresDBName,
+		GlobalConfig.PostgresUseSSL,
+	)
+	if GlobalConfig.DisablePostgres {
+		opt = database.WithSQLite(GlobalConfig.SQLitePath)
+	}
+
+	db := database.New(opt)
+
+	err = db.Connect()
+	errz.Fatal(err)
+
+	return db, nil
+}
+
+// newArtifactStore creates the storage backend for artifact payloads.
+// When s3 is disabled artifacts are stored on the local filesystem
+// and bobc serves downloads itself through the returned downloader.
+func newArtifactStore() (_ projectrepo.ArtifactStore, _ restserver.Downloader, err error) {
+	defer errz.Recover(&err)
+
+	if GlobalConfig.DisableS3 {
+		baseURL, err := url.Parse(GlobalConfig.PublicURL)
+		errz.Fatal(err)
+
+		if GlobalConfig.DownloadSigningKey == "" {
+			log.Warn().Msg("No download signing key set, download links become invalid on restart.")
+		}
+
+		store := localstore.New(
+			GlobalConfig.StorageDir,
+			localstore.WithBaseURL(baseURL),
+			localstore.WithSigningKey([]byte(GlobalConfig.DownloadSigningKey)),
+		)
+		log.Info().Str("dir", GlobalConfig.StorageDir).Msg("Storing artifacts on the local filesystem.")
+
+		return store, store, nil
+	}
+
+	minioClient, err := minio.New(GlobalConfig.S3Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(GlobalConfig.S3AccessKeyID, GlobalConfig.S3SecretAccessKey, ""),
+		Secure: GlobalConfig.S3UseSSL,
+	})
+	errz.Fatal(err)
+
+	err = minioClient.MakeBucket(context.Background(), GlobalConfig.S3BucketName, minio.MakeBucketOptions{})
+	if err != nil {
+		// Check to see if we already own this bucket (which happens if you run this twice)
+		exists, errBucketExists := minioClient.BucketExists(context.Background(), GlobalConfig.S3BucketName)
+		if errBucketExists == nil && exists {
+			log.Info().Str("bucket", GlobalConfig.S3BucketName).Msg("Using existing bucket.")
+		} else {
+			errz.Fatal(err)
+		}
+	} else {
+		log.Info().Str("bucket", GlobalConfig.S3BucketName).Msg("Bucket created.")
+	}
+
+	store := artifactstore.New(
+		minioClient,
+		artifactstore.WithBucketName(GlobalConfig.S3BucketName),
+	)
+
+	return store, nil, nil
 }
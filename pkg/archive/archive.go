@@ -0,0 +1,222 @@
+package archive
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/benchkram/errz"
+)
+
+var (
+	ErrInvalidArchive     = fmt.Errorf("invalid archive")
+	ErrUnsupportedVersion = fmt.Errorf("unsupported archive version")
+)
+
+// Version of the archive format written.
+const Version = 1
+
+const (
+	manifestName   = "manifest.json"
+	artifactPrefix = "artifacts/"
+)
+
+// Manifest describes the project stored in an archive.
+// It's the first entry of an archive, followed by the payloads
+// of the artifacts in the order they are listed.
+type Manifest struct {
+	Version int       `json:"version"`
+	Project Project   `json:"project"`
+	Created time.Time `json:"created"`
+
+	Artifacts []Artifact `json:"artifacts"`
+}
+
+type Project struct {
+	Organization string `json:"organization"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	Public       bool   `json:"public"`
+}
+
+type Artifact struct {
+	ID     string `json:"id"`
+	Scope  string `json:"scope,omitempty"`
+	Size   int64  `json:"size"`
+	Digest string `json:"digest,omitempty"`
+
+	CreatedAt      time.Time `json:"createdAt"`
+	LastAccessedAt time.Time `json:"lastAccessedAt"`
+}
+
+// Report is the result of importing an archive.
+type Report struct {
+	// Project is the path of the project imported to
+	Project string
+
+	// Imported is the number of artifacts created
+	Imported int
+
+	// Skipped is the number of artifacts which already existed
+	Skipped int
+}
+
+// Writer writes an archive as gzip compressed tar.
+type Writer struct {
+	gz *gzip.Writer
+	tw *tar.Writer
+
+	manifest *Manifest
+	next     int
+}
+
+func NewWriter(w io.Writer) *Writer {
+	gz := gzip.NewWriter(w)
+	return &Writer{
+		gz: gz,
+		tw: tar.NewWriter(gz),
+	}
+}
+
+// WriteManifest writes the manifest, must be called first.
+func (w *Writer) WriteManifest(m *Manifest) (err error) {
+	defer errz.Recover(&err)
+
+	m.Version = Version
+
+	b, err := json.MarshalIndent(m, "", "  ")
+	errz.Fatal(err)
+
+	err = w.tw.WriteHeader(&tar.Header{
+		Name:    manifestName,
+		Mode:    0644,
+		Size:    int64(len(b)),
+		ModTime: m.Created,
+	})
+	errz.Fatal(err)
+
+	_, err = w.tw.Write(b)
+	errz.Fatal(err)
+
+	w.manifest = m
+
+	return nil
+}
+
+// WriteArtifact writes the payload of the next artifact of the manifest.
+// src must provide exactly the size listed in the manifest.
+func (w *Writer) WriteArtifact(src io.Reader) (err error) {
+	defer errz.Recover(&err)
+
+	if w.manifest == nil || w.next >= len(w.manifest.Artifacts) {
+		return fmt.Errorf("no further artifact in manifest")
+	}
+	a := w.manifest.Artifacts[w.next]
+
+	err = w.tw.WriteHeader(&tar.Header{
+		Name:    artifactPrefix + strconv.Itoa(w.next),
+		Mode:    0644,
+		Size:    a.Size,
+		ModTime: a.CreatedAt,
+	})
+	errz.Fatal(err)
+
+	n, err := io.Copy(w.tw, src)
+	errz.Fatal(err)
+
+	if n != a.Size {
+		return fmt.Errorf("size of artifact %s is %d, expected %d", a.ID, n, a.Size)
+	}
+
+	w.next++
+
+	return nil
+}
+
+// Close finishes the archive, it doesn't close the underlying writer.
+func (w *Writer) Close() (err error) {
+	defer errz.Recover(&err)
+
+	err = w.tw.Close()
+	errz.Fatal(err)
+
+	return w.gz.Close()
+}
+
+// Reader reads an archive written by Writer.
+type Reader struct {
+	gz *gzip.Reader
+	tr *tar.Reader
+
+	manifest *Manifest
+	next     int
+}
+
+// NewReader starts reading an archive and reads its manifest.
+func NewReader(r io.Reader) (_ *Reader, err error) {
+	defer errz.Recover(&err)
+
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, ErrInvalidArchive
+	}
+
+	ar := &Reader{
+		gz: gz,
+		tr: tar.NewReader(gz),
+	}
+
+	h, err := ar.tr.Next()
+	if err != nil || h.Name != manifestName {
+		return nil, ErrInvalidArchive
+	}
+
+	m := &Manifest{}
+	err = json.NewDecoder(ar.tr).Decode(m)
+	if err != nil {
+		return nil, ErrInvalidArchive
+	}
+
+	if m.Version != Version {
+		return nil, ErrUnsupportedVersion
+	}
+
+	ar.manifest = m
+
+	return ar, nil
+}
+
+func (r *Reader) Manifest() *Manifest {
+	return r.manifest
+}
+
+// Next returns the next artifact and its payload, which is only
+// valid until the next call. Returns io.EOF after the last one.
+// The payloads must follow in the order of the manifest, each once.
+func (r *Reader) Next() (_ *Artifact, _ io.Reader, err error) {
+	defer errz.Recover(&err)
+
+	h, err := r.tr.Next()
+	if err == io.EOF && r.next == len(r.manifest.Artifacts) {
+		return nil, nil, io.EOF
+	} else if err != nil {
+		return nil, nil, ErrInvalidArchive
+	}
+
+	if r.next >= len(r.manifest.Artifacts) || h.Name != artifactPrefix+strconv.Itoa(r.next) {
+		return nil, nil, ErrInvalidArchive
+	}
+
+	a := &r.manifest.Artifacts[r.next]
+	if h.Size != a.Size {
+		return nil, nil, ErrInvalidArchive
+	}
+
+	r.next++
+
+	return a, r.tr, nil
+}
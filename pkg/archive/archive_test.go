@@ -0,0 +1,123 @@
+package archive
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoundTrip(t *testing.T) {
+	m := &Manifest{
+		Project: Project{Organization: "benchkram", Name: "bobc-example", Public: true},
+		Created: time.Now().UTC(),
+		Artifacts: []Artifact{
+			{ID: "a", Size: 5},
+			{ID: "a", Scope: "main", Size: 0},
+			{ID: "b", Size: 3},
+		},
+	}
+
+	buf := &bytes.Buffer{}
+	w := NewWriter(buf)
+	require.Nil(t, w.WriteManifest(m))
+	require.Nil(t, w.WriteArtifact(strings.NewReader("hello")))
+	require.Nil(t, w.WriteArtifact(strings.NewReader("")))
+	require.Nil(t, w.WriteArtifact(strings.NewReader("foo")))
+	assert.NotNil(t, w.WriteArtifact(strings.NewReader("")))
+	require.Nil(t, w.Close())
+
+	r, err := NewReader(buf)
+	require.Nil(t, err)
+	assert.Equal(t, Version, r.Manifest().Version)
+	assert.Equal(t, "bobc-example", r.Manifest().Project.Name)
+
+	payloads := []string{}
+	for {
+		a, src, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		require.Nil(t, err)
+
+		b, err := ioutil.ReadAll(src)
+		require.Nil(t, err)
+		payloads = append(payloads, a.Scope+":"+a.ID+":"+string(b))
+	}
+	assert.Equal(t, []string{":a:hello", "main:a:", ":b:foo"}, payloads)
+}
+
+func TestSizeMismatch(t *testing.T) {
+	w := NewWriter(ioutil.Discard)
+	require.Nil(t, w.WriteManifest(&Manifest{Artifacts: []Artifact{{ID: "a", Size: 5}}}))
+	assert.NotNil(t, w.WriteArtifact(strings.NewReader("hi")))
+}
+
+func TestInvalidArchive(t *testing.T) {
+	_, err := NewReader(strings.NewReader("no archive"))
+	assert.ErrorIs(t, err, ErrInvalidArchive)
+}
+
+// rawArchive writes an archive holding m followed by entries named as given,
+// each with the size of the artifact listed first in m.
+func rawArchive(t *testing.T, m *Manifest, names ...string) *bytes.Buffer {
+	buf := &bytes.Buffer{}
+	gz := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gz)
+
+	m.Version = Version
+	b, err := json.Marshal(m)
+	require.Nil(t, err)
+	require.Nil(t, tw.WriteHeader(&tar.Header{Name: manifestName, Mode: 0644, Size: int64(len(b))}))
+	_, err = tw.Write(b)
+	require.Nil(t, err)
+
+	for _, name := range names {
+		require.Nil(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: 1}))
+		_, err = tw.Write([]byte("x"))
+		require.Nil(t, err)
+	}
+
+	require.Nil(t, tw.Close())
+	require.Nil(t, gz.Close())
+
+	return buf
+}
+
+func TestArtifactOrder(t *testing.T) {
+	m := func() *Manifest {
+		return &Manifest{Artifacts: []Artifact{{ID: "a", Size: 1}, {ID: "b", Size: 1}}}
+	}
+
+	readAll := func(buf *bytes.Buffer) error {
+		r, err := NewReader(buf)
+		require.Nil(t, err)
+		for {
+			_, _, err := r.Next()
+			if err == io.EOF {
+				return nil
+			} else if err != nil {
+				return err
+			}
+		}
+	}
+
+	assert.Nil(t, readAll(rawArchive(t, m(), "artifacts/0", "artifacts/1")))
+
+	// out of order
+	assert.ErrorIs(t, readAll(rawArchive(t, m(), "artifacts/1", "artifacts/0")), ErrInvalidArchive)
+	// duplicates
+	assert.ErrorIs(t, readAll(rawArchive(t, m(), "artifacts/0", "artifacts/0", "artifacts/1")), ErrInvalidArchive)
+	// missing artifacts
+	assert.ErrorIs(t, readAll(rawArchive(t, m(), "artifacts/0")), ErrInvalidArchive)
+	// unlisted artifacts
+	assert.ErrorIs(t, readAll(rawArchive(t, m(), "artifacts/0", "artifacts/1", "artifacts/2")), ErrInvalidArchive)
+}
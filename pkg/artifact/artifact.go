@@ -2,6 +2,7 @@ package artifact
 
 import (
 	"net/url"
+	"time"
 
 	"github.com/benchkram/bobc/pkg/db/model"
 	"github.com/benchkram/bobc/pkg/optional"
@@ -22,13 +23,31 @@ type A struct {
 
 	// Size of the artifact in bytes
 	Size int
+
+	// Scope the artifact was uploaded to, e.g. a branch.
+	// Empty for the default scope.
+	Scope string
+
+	// Digest is the hex encoded sha256 checksum of the artifact's
+	// payload. Empty for artifacts uploaded before digests were recorded.
+	Digest string
+
+	CreatedAt time.Time
+
+	// LastAccessedAt is the last time a download link was handed out
+	LastAccessedAt time.Time
 }
 
 func FromDatabaseType(m *model.Artifact) *A {
 	return &A{
-		UUID: uuid.MustParse(m.ID),
-		ID:   m.ArtifactID,
-		Size: m.Size,
+		UUID:   uuid.MustParse(m.ID),
+		ID:     m.ArtifactID,
+		Size:   m.Size,
+		Scope:  m.Scope,
+		Digest: m.Digest,
+
+		CreatedAt:      m.CreatedAt,
+		LastAccessedAt: m.LastAccessedAt,
 	}
 }
 
@@ -38,6 +57,10 @@ func (a *A) ToDatabaseType(projectID string) *model.Artifact {
 		ArtifactID: a.ID,
 		ProjectID:  projectID,
 		Size:       a.Size,
+		Scope:      a.Scope,
+		Digest:     a.Digest,
+
+		LastAccessedAt: a.LastAccessedAt,
 	}
 }
 
@@ -46,9 +69,19 @@ func (a *A) ToRestType() generated.Artifact {
 	if a.AccessLink != nil {
 		link = optional.String(a.AccessLink.String())
 	}
+	var digest *string
+	if a.Digest != "" {
+		digest = optional.String(a.Digest)
+	}
+	var scope *string
+	if a.Scope != "" {
+		scope = optional.String(a.Scope)
+	}
 	return generated.Artifact{
 		Id:       a.ID,
 		Location: link,
 		Size:     a.Size,
+		Digest:   digest,
+		Scope:    scope,
 	}
 }
@@ -2,22 +2,32 @@ package artifactstore
 
 import (
 	"context"
+	"io"
 	"net/url"
 	"time"
 
+	"github.com/benchkram/bobc/pkg/metrics"
+	"github.com/benchkram/bobc/pkg/tracing"
 	"github.com/benchkram/errz"
 	"github.com/minio/minio-go/v7"
 )
 
-func (r *Repository) CreateArtifact(id string, filePath string, size int) (err error) {
+// CreateArtifact streams src to the bucket. The size of src doesn't need
+// to be known upfront, it's uploaded in parts of the configured part size.
+func (r *Repository) CreateArtifact(ctx context.Context, id string, src io.Reader) (err error) {
+	ctx, span := tracing.Start(ctx, "artifactstore.CreateArtifact")
+	defer tracing.End(span, &err)
+	defer metrics.Error(metrics.S3, &err)
 	defer errz.Recover(&err)
 
-	_, err = r.minio.FPutObject(context.Background(),
+	_, err = r.minio.PutObject(ctx,
 		r.bucketName,
 		id,
-		filePath,
+		src,
+		-1,
 		minio.PutObjectOptions{
 			ContentType: "application/tar+gzip",
+			PartSize:    r.partSize,
 		},
 	)
 	errz.Fatal(err)
@@ -25,11 +35,14 @@ func (r *Repository) CreateArtifact(id string, filePath string, size int) (err e
 	return nil
 }
 
-func (r *Repository) DeleteArtifact(id string) (err error) {
+func (r *Repository) DeleteArtifact(ctx context.Context, id string) (err error) {
+	ctx, span := tracing.Start(ctx, "artifactstore.DeleteArtifact")
+	defer tracing.End(span, &err)
+	defer metrics.Error(metrics.S3, &err)
 	defer errz.Recover(&err)
 
 	err = r.minio.RemoveObject(
-		context.Background(),
+		ctx,
 		r.bucketName,
 		id,
 		minio.RemoveObjectOptions{},
@@ -39,14 +52,17 @@ func (r *Repository) DeleteArtifact(id string) (err error) {
 	return nil
 }
 
-func (r *Repository) Artifact(id string) (addr *url.URL, err error) {
+func (r *Repository) Artifact(ctx context.Context, id string) (addr *url.URL, err error) {
+	ctx, span := tracing.Start(ctx, "artifactstore.Artifact")
+	defer tracing.End(span, &err)
+	defer metrics.Error(metrics.S3, &err)
 	defer errz.Recover(&err)
 
 	reqParams := make(url.Values)
 	reqParams.Set("response-content-disposition", "attachment; filename=\""+id+"\"")
 
 	addr, err = r.minio.PresignedGetObject(
-		context.Background(),
+		ctx,
 		r.bucketName,
 		id,
 		10*time.Minute,
@@ -54,5 +70,25 @@ func (r *Repository) Artifact(id string) (addr *url.URL, err error) {
 	)
 	errz.Fatal(err)
 
+	metrics.Presigns.WithLabelValues(metrics.PresignGet).Inc()
+
 	return addr, nil
 }
+
+// ReadArtifact opens the payload of the artifact id for reading.
+func (r *Repository) ReadArtifact(ctx context.Context, id string) (_ io.ReadCloser, err error) {
+	ctx, span := tracing.Start(ctx, "artifactstore.ReadArtifact")
+	defer tracing.End(span, &err)
+	defer metrics.Error(metrics.S3, &err)
+	defer errz.Recover(&err)
+
+	obj, err := r.minio.GetObject(
+		ctx,
+		r.bucketName,
+		id,
+		minio.GetObjectOptions{},
+	)
+	errz.Fatal(err)
+
+	return obj, nil
+}
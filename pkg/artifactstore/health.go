@@ -0,0 +1,29 @@
+package artifactstore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/benchkram/bobc/pkg/metrics"
+	"github.com/benchkram/bobc/pkg/tracing"
+	"github.com/benchkram/errz"
+)
+
+var ErrBucketNotFound = fmt.Errorf("bucket not found")
+
+// Ping checks that the bucket is accessible.
+func (r *Repository) Ping(ctx context.Context) (err error) {
+	ctx, span := tracing.Start(ctx, "artifactstore.Ping")
+	defer tracing.End(span, &err)
+	defer metrics.Error(metrics.S3, &err)
+	defer errz.Recover(&err)
+
+	exists, err := r.minio.BucketExists(ctx, r.bucketName)
+	errz.Fatal(err)
+
+	if !exists {
+		return ErrBucketNotFound
+	}
+
+	return nil
+}
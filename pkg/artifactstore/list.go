@@ -0,0 +1,37 @@
+package artifactstore
+
+import (
+	"context"
+	"time"
+
+	"github.com/benchkram/bobc/pkg/metrics"
+	"github.com/benchkram/bobc/pkg/tracing"
+	"github.com/benchkram/errz"
+	"github.com/minio/minio-go/v7"
+)
+
+// ListArtifacts calls fn for every object in the bucket.
+// Parts of multipart uploads in progress are not listed.
+func (r *Repository) ListArtifacts(ctx context.Context, fn func(id string, modified time.Time) error) (err error) {
+	ctx, span := tracing.Start(ctx, "artifactstore.ListArtifacts")
+	defer tracing.End(span, &err)
+	defer errz.Recover(&err)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	objects := r.minio.ListObjects(ctx, r.bucketName, minio.ListObjectsOptions{
+		Recursive: true,
+	})
+	for obj := range objects {
+		if obj.Err != nil {
+			metrics.BackendErrors.WithLabelValues(metrics.S3).Inc()
+		}
+		errz.Fatal(obj.Err)
+
+		err = fn(obj.Key, obj.LastModified)
+		errz.Fatal(err)
+	}
+
+	return nil
+}
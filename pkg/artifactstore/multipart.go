@@ -0,0 +1,104 @@
+package artifactstore
+
+import (
+	"context"
+	"io"
+
+	"github.com/benchkram/bobc/pkg/metrics"
+	"github.com/benchkram/bobc/pkg/tracing"
+	"github.com/benchkram/errz"
+	"github.com/minio/minio-go/v7"
+)
+
+// NewMultipartUpload starts an upload of the artifact id in multiple parts.
+// Parts must be at least 5MiB in size, except the last one.
+func (r *Repository) NewMultipartUpload(ctx context.Context, id string) (uploadID string, err error) {
+	ctx, span := tracing.Start(ctx, "artifactstore.NewMultipartUpload")
+	defer tracing.End(span, &err)
+	defer metrics.Error(metrics.S3, &err)
+	defer errz.Recover(&err)
+
+	uploadID, err = r.core().NewMultipartUpload(ctx,
+		r.bucketName,
+		id,
+		minio.PutObjectOptions{
+			ContentType: "application/tar+gzip",
+		},
+	)
+	errz.Fatal(err)
+
+	return uploadID, nil
+}
+
+// PutPart uploads a single part of a multipart upload. Uploading a part
+// with the same number again replaces the previous one.
+func (r *Repository) PutPart(ctx context.Context, id, uploadID string, number int, src io.Reader, size int64) (etag string, err error) {
+	ctx, span := tracing.Start(ctx, "artifactstore.PutPart")
+	defer tracing.End(span, &err)
+	defer metrics.Error(metrics.S3, &err)
+	defer errz.Recover(&err)
+
+	part, err := r.core().PutObjectPart(ctx,
+		r.bucketName,
+		id,
+		uploadID,
+		number,
+		src,
+		size,
+		"",
+		"",
+		nil,
+	)
+	errz.Fatal(err)
+
+	return part.ETag, nil
+}
+
+// CompleteMultipartUpload assembles the artifact from its parts.
+// etags must be ordered by part number, starting with part 1.
+func (r *Repository) CompleteMultipartUpload(ctx context.Context, id, uploadID string, etags []string) (err error) {
+	ctx, span := tracing.Start(ctx, "artifactstore.CompleteMultipartUpload")
+	defer tracing.End(span, &err)
+	defer metrics.Error(metrics.S3, &err)
+	defer errz.Recover(&err)
+
+	parts := make([]minio.CompletePart, 0, len(etags))
+	for i, etag := range etags {
+		parts = append(parts, minio.CompletePart{
+			PartNumber: i + 1,
+			ETag:       etag,
+		})
+	}
+
+	_, err = r.core().CompleteMultipartUpload(ctx,
+		r.bucketName,
+		id,
+		uploadID,
+		parts,
+		minio.PutObjectOptions{},
+	)
+	errz.Fatal(err)
+
+	return nil
+}
+
+func (r *Repository) AbortMultipartUpload(ctx context.Context, id, uploadID string) (err error) {
+	ctx, span := tracing.Start(ctx, "artifactstore.AbortMultipartUpload")
+	defer tracing.End(span, &err)
+	defer metrics.Error(metrics.S3, &err)
+	defer errz.Recover(&err)
+
+	err = r.core().AbortMultipartUpload(ctx,
+		r.bucketName,
+		id,
+		uploadID,
+	)
+	errz.Fatal(err)
+
+	return nil
+}
+
+// core gives access to the low level s3 api required for multipart uploads.
+func (r *Repository) core() minio.Core {
+	return minio.Core{Client: r.minio}
+}
@@ -15,3 +15,9 @@ func WithBucketName(bn string) Option {
 		r.bucketName = bn
 	}
 }
+
+func WithPartSize(size uint64) Option {
+	return func(r *Repository) {
+		r.partSize = size
+	}
+}
@@ -0,0 +1,118 @@
+package artifactstore
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/benchkram/bobc/pkg/metrics"
+	"github.com/benchkram/bobc/pkg/tracing"
+	"github.com/benchkram/errz"
+	"github.com/minio/minio-go/v7"
+)
+
+// PresignedPut returns a link allowing to upload the artifact id
+// directly to the bucket with a single PUT request.
+func (r *Repository) PresignedPut(ctx context.Context, id string, expiry time.Duration) (addr *url.URL, err error) {
+	ctx, span := tracing.Start(ctx, "artifactstore.PresignedPut")
+	defer tracing.End(span, &err)
+	defer metrics.Error(metrics.S3, &err)
+	defer errz.Recover(&err)
+
+	addr, err = r.minio.PresignedPutObject(
+		ctx,
+		r.bucketName,
+		id,
+		expiry,
+	)
+	errz.Fatal(err)
+
+	metrics.Presigns.WithLabelValues(metrics.PresignPut).Inc()
+
+	return addr, nil
+}
+
+// PresignedPutPart returns a link allowing to upload a part
+// of a multipart upload directly to the bucket.
+func (r *Repository) PresignedPutPart(ctx context.Context, id, uploadID string, number int, expiry time.Duration) (addr *url.URL, err error) {
+	ctx, span := tracing.Start(ctx, "artifactstore.PresignedPutPart")
+	defer tracing.End(span, &err)
+	defer metrics.Error(metrics.S3, &err)
+	defer errz.Recover(&err)
+
+	reqParams := make(url.Values)
+	reqParams.Set("partNumber", strconv.Itoa(number))
+	reqParams.Set("uploadId", uploadID)
+
+	addr, err = r.minio.Presign(
+		ctx,
+		http.MethodPut,
+		r.bucketName,
+		id,
+		expiry,
+		reqParams,
+	)
+	errz.Fatal(err)
+
+	metrics.Presigns.WithLabelValues(metrics.PresignPutPart).Inc()
+
+	return addr, nil
+}
+
+// ListParts returns the etags of the parts of a multipart
+// upload received by the bucket, keyed by part number,
+// and the total size of the parts.
+func (r *Repository) ListParts(ctx context.Context, id, uploadID string) (etags map[int]string, size int64, err error) {
+	ctx, span := tracing.Start(ctx, "artifactstore.ListParts")
+	defer tracing.End(span, &err)
+	defer metrics.Error(metrics.S3, &err)
+	defer errz.Recover(&err)
+
+	etags = map[int]string{}
+	marker := 0
+	for {
+		result, err := r.core().ListObjectParts(ctx,
+			r.bucketName,
+			id,
+			uploadID,
+			marker,
+			1000,
+		)
+		errz.Fatal(err)
+
+		for _, p := range result.ObjectParts {
+			etags[p.PartNumber] = p.ETag
+			size += p.Size
+		}
+
+		if !result.IsTruncated {
+			break
+		}
+		marker = result.NextPartNumberMarker
+	}
+
+	return etags, size, nil
+}
+
+// Stat returns the size of the artifact id as stored in the bucket.
+func (r *Repository) Stat(ctx context.Context, id string) (size int64, exists bool, err error) {
+	ctx, span := tracing.Start(ctx, "artifactstore.Stat")
+	defer tracing.End(span, &err)
+	defer metrics.Error(metrics.S3, &err)
+	defer errz.Recover(&err)
+
+	info, err := r.minio.StatObject(
+		ctx,
+		r.bucketName,
+		id,
+		minio.StatObjectOptions{},
+	)
+	if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+		return 0, false, nil
+	}
+	errz.Fatal(err)
+
+	return info.Size, true, nil
+}
@@ -10,12 +10,17 @@ type Repository struct {
 
 	// bucketName used to store artifacts
 	bucketName string
+
+	// partSize used for multipart uploads. A buffer of this
+	// size is allocated for every upload in progress.
+	partSize uint64
 }
 
 func New(minioClient *minio.Client, opts ...Option) *Repository {
 	r := &Repository{
 		minio:      minioClient,
 		bucketName: "artifacts",
+		partSize:   16 << 20,
 	}
 
 	for _, opt := range opts {
@@ -0,0 +1,168 @@
+package cacheimport
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/benchkram/bobc/pkg/logging"
+	restserverclient "github.com/benchkram/bobc/rest-server-client"
+	"github.com/benchkram/bobc/restserver/generated"
+	"github.com/benchkram/errz"
+	"github.com/rs/zerolog/log"
+)
+
+// existBatchSize is the number of artifacts checked with
+// a single request, the maximum accepted by the server.
+const existBatchSize = 1000
+
+// Client uploads artifacts to a bobc server, see rest-server-client.
+type Client interface {
+	ArtifactsExist(projectId string, hashes []string) (*generated.ArtifactsExist, error)
+	ArtifactCreate(projectId string, hash string, src string) error
+}
+
+// Report sums up the artifacts of a cache directory by their outcome.
+type Report struct {
+	Uploaded      int
+	UploadedBytes int64
+
+	Skipped      int
+	SkippedBytes int64
+
+	Failed      int
+	FailedBytes int64
+}
+
+func (r *Report) Summary() string {
+	return fmt.Sprintf("uploaded %d artifacts (%d bytes), skipped %d existing (%d bytes), %d failed (%d bytes)",
+		r.Uploaded, r.UploadedBytes, r.Skipped, r.SkippedBytes, r.Failed, r.FailedBytes)
+}
+
+type file struct {
+	id   string
+	path string
+	size int64
+}
+
+// Import uploads the artifacts found in dir, a bob artifact cache,
+// to a project. The name of each file is used as artifact id.
+// Artifacts which already exist are skipped, the others are
+// uploaded by the given number of workers in parallel.
+func Import(c Client, project, dir string, workers int) (_ *Report, err error) {
+	defer errz.Recover(&err)
+
+	files, err := walk(dir)
+	errz.Fatal(err)
+
+	report := &Report{}
+
+	missing := []file{}
+	for start := 0; start < len(files); start += existBatchSize {
+		end := start + existBatchSize
+		if end > len(files) {
+			end = len(files)
+		}
+		batch := files[start:end]
+
+		ids := make([]string, 0, len(batch))
+		for _, f := range batch {
+			ids = append(ids, f.id)
+		}
+
+		exist, err := c.ArtifactsExist(project, ids)
+		errz.Fatal(err)
+
+		present := map[string]bool{}
+		for _, id := range exist.Present {
+			present[id] = true
+		}
+
+		for _, f := range batch {
+			if present[f.id] {
+				report.Skipped++
+				report.SkippedBytes += f.size
+			} else {
+				missing = append(missing, f)
+			}
+		}
+	}
+
+	if workers < 1 {
+		workers = 1
+	}
+
+	var mux sync.Mutex
+	var wg sync.WaitGroup
+	queue := make(chan file)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for f := range queue {
+				err := c.ArtifactCreate(project, f.id, f.path)
+
+				mux.Lock()
+				switch {
+				case err == nil:
+					report.Uploaded++
+					report.UploadedBytes += f.size
+				case errors.Is(err, restserverclient.ErrItemAlreadyExists):
+					report.Skipped++
+					report.SkippedBytes += f.size
+				default:
+					log.Error().Err(err).Str(logging.FieldArtifactID, f.id).Msg("Upload of artifact failed.")
+					report.Failed++
+					report.FailedBytes += f.size
+				}
+				mux.Unlock()
+			}
+		}()
+	}
+
+	for _, f := range missing {
+		queue <- f
+	}
+	close(queue)
+	wg.Wait()
+
+	return report, nil
+}
+
+// walk lists the files of dir and its subdirectories.
+// Hidden files, e.g. temporary files of bob, are ignored.
+func walk(dir string) (_ []file, err error) {
+	defer errz.Recover(&err)
+
+	files := []file{}
+	err = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if strings.HasPrefix(d.Name(), ".") && path != dir {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		files = append(files, file{id: d.Name(), path: path, size: info.Size()})
+		return nil
+	})
+	errz.Fatal(err)
+
+	return files, nil
+}
@@ -0,0 +1,87 @@
+package cacheimport
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	restserverclient "github.com/benchkram/bobc/rest-server-client"
+	"github.com/benchkram/bobc/restserver/generated"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeClient struct {
+	mux      sync.Mutex
+	present  map[string]bool
+	conflict map[string]bool
+	fail     map[string]bool
+	uploaded []string
+}
+
+func (c *fakeClient) ArtifactsExist(projectId string, hashes []string) (*generated.ArtifactsExist, error) {
+	e := &generated.ArtifactsExist{Missing: []string{}, Present: []string{}}
+	for _, h := range hashes {
+		if c.present[h] {
+			e.Present = append(e.Present, h)
+		} else {
+			e.Missing = append(e.Missing, h)
+		}
+	}
+	return e, nil
+}
+
+func (c *fakeClient) ArtifactCreate(projectId string, hash string, src string) error {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if c.conflict[hash] {
+		return restserverclient.ErrItemAlreadyExists
+	}
+	if c.fail[hash] {
+		return fmt.Errorf("upload failed")
+	}
+	c.uploaded = append(c.uploaded, hash)
+	return nil
+}
+
+func TestImport(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name string, size int) {
+		require.Nil(t, os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0755))
+		require.Nil(t, os.WriteFile(filepath.Join(dir, name), make([]byte, size), 0644))
+	}
+	write("aaa", 1)
+	write("bbb", 2)
+	write("ccc", 4)
+	write("ddd", 8)
+	write("sub/eee", 16)
+	write(".tmp", 32)
+	write(".hidden/fff", 64)
+
+	c := &fakeClient{
+		present:  map[string]bool{"aaa": true},
+		conflict: map[string]bool{"bbb": true},
+		fail:     map[string]bool{"ccc": true},
+	}
+
+	report, err := Import(c, "acme/app", dir, 2)
+	require.Nil(t, err)
+
+	assert.ElementsMatch(t, []string{"ddd", "eee"}, c.uploaded)
+	assert.Equal(t, &Report{
+		Uploaded:      2,
+		UploadedBytes: 24,
+		Skipped:       2,
+		SkippedBytes:  3,
+		Failed:        1,
+		FailedBytes:   4,
+	}, report)
+}
+
+func TestImportMissingDir(t *testing.T) {
+	_, err := Import(&fakeClient{}, "acme/app", filepath.Join(t.TempDir(), "missing"), 1)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,46 @@
+package checksum
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"io"
+)
+
+// Reader passes reads through to an underlying reader while counting
+// the bytes read and computing their sha256 checksum on the fly.
+type Reader struct {
+	r    io.Reader
+	hash hash.Hash
+	size int64
+}
+
+func NewReader(r io.Reader) *Reader {
+	return &Reader{
+		r:    r,
+		hash: sha256.New(),
+	}
+}
+
+func (r *Reader) Read(p []byte) (n int, err error) {
+	n, err = r.r.Read(p)
+	r.size += int64(n)
+	r.hash.Write(p[:n])
+	return n, err
+}
+
+// Size returns the number of bytes read so far.
+func (r *Reader) Size() int64 {
+	return r.size
+}
+
+// Sum returns the hex encoded sha256 checksum of the bytes read so far.
+func (r *Reader) Sum() string {
+	return hex.EncodeToString(r.hash.Sum(nil))
+}
+
+// Valid reports if digest is a hex encoded sha256 checksum.
+func Valid(digest string) bool {
+	b, err := hex.DecodeString(digest)
+	return err == nil && len(b) == sha256.Size
+}
@@ -1,10 +1,11 @@
 package db
 
 import (
+	"context"
 	"errors"
-	"log"
 
 	"github.com/benchkram/errz"
+	"github.com/rs/zerolog/log"
 	"gorm.io/gorm"
 )
 
@@ -14,6 +15,7 @@ var (
 	ErrInvalidDatabaseType = errors.New("Invalid database type")
 	ErrStateNotFound       = errors.New("state not found")
 	ErrDuplicateUserMail   = errors.New("User with this email already in database")
+	ErrMigrationsPending   = errors.New("Migrations pending")
 )
 
 const (
@@ -32,6 +34,11 @@ const (
 type Database interface {
 	Gorm() *gorm.DB
 	Connect() error
+
+	// Ping checks the connection to the database.
+	Ping(ctx context.Context) error
+	// Migrated checks that the schema is up to date.
+	Migrated(ctx context.Context) error
 }
 
 type database struct {
@@ -52,6 +59,9 @@ type database struct {
 	password     string
 	databaseName string
 	useSSL       bool
+
+	// sqlite
+	sqlitePath string
 }
 
 func New(opts ...Option) Database {
@@ -75,12 +85,25 @@ func (db *database) Connect() (err error) {
 		gormDB, err := db.connectPostgres()
 		errz.Fatal(err)
 
+		db.gorm = gormDB
+	case SQLite:
+		gormDB, err := db.connectSQLite()
+		errz.Fatal(err)
+
 		db.gorm = gormDB
 	default:
 		return ErrInvalidDatabaseType
 	}
 
-	log.Printf("Successfully connected to database [%s]\n", db.dbType)
+	log.Info().Str("type", string(db.dbType)).Msg("Connected to database.")
+
+	if db.dbType != SQLite {
+		err = registerMetrics(db.gorm)
+		errz.Fatal(err)
+	}
+
+	err = registerTracing(db.gorm, db.dbType)
+	errz.Fatal(err)
 
 	err = db.migrate()
 	errz.Fatal(err)
@@ -9,4 +9,5 @@ func (dbt *DatabaseType) String() string {
 const (
 	Postgres             DatabaseType = "postgres"
 	DigitalOceanPostgres DatabaseType = "digital_ocean_postgres"
+	SQLite               DatabaseType = "sqlite"
 )
@@ -3,12 +3,12 @@ package db
 import (
 	"context"
 	"fmt"
-	"log"
 	"time"
 
 	"github.com/avast/retry-go"
 	"github.com/benchkram/errz"
 	"github.com/digitalocean/godo"
+	"github.com/rs/zerolog/log"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
@@ -26,13 +26,11 @@ func (db *database) connectDigitalOceanPostgres() (gormDB *gorm.DB, err error) {
 func getDatabaseConnection(token, clusterID, databaseID string, privateDBConn bool) (conn *godo.DatabaseConnection, err error) {
 	defer errz.Recover(&err)
 
-	fmt.Println("get database connection")
-
 	var cluster *godo.Database
 	attempt := 1
 	err = retry.Do(
 		func() error {
-			log.Printf("Trying to get database connection: [attempt: %d]\n", attempt)
+			log.Info().Int("attempt", attempt).Msg("Getting database connection.")
 			attempt++
 
 			client := godo.NewFromToken(token)
@@ -53,7 +51,7 @@ func getDatabaseConnection(token, clusterID, databaseID string, privateDBConn bo
 	errz.Fatal(err)
 
 	// litter.Dump(cluster)
-	fmt.Printf("using private connection: %v\n", privateDBConn)
+	log.Debug().Bool("private", privateDBConn).Msg("Database connection received.")
 
 	if privateDBConn {
 		return cluster.PrivateConnection, nil
@@ -65,8 +63,6 @@ func getDatabaseConnection(token, clusterID, databaseID string, privateDBConn bo
 func connectGorm(conn *godo.DatabaseConnection) (db *gorm.DB, err error) {
 	defer errz.Recover(&err)
 
-	fmt.Println("get gorm db")
-
 	SSLMode := "disable"
 
 	// TODO: enable ssl
@@ -75,9 +71,12 @@ func connectGorm(conn *godo.DatabaseConnection) (db *gorm.DB, err error) {
 	}
 
 	dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%d sslmode=%s", conn.Host, conn.User, conn.Password, conn.Database, conn.Port, SSLMode)
-	fmt.Printf("dsn: %s\n", dsn)
-	fmt.Println("try to open gorm")
-	db, err = gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	log.Debug().
+		Str("host", conn.Host).
+		Int("port", conn.Port).
+		Str("database", conn.Database).
+		Msg("Opening database.")
+	db, err = gorm.Open(postgres.Open(dsn), gormConfig())
 	errz.Fatal(err)
 
 	return db, nil
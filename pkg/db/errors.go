@@ -0,0 +1,26 @@
+package db
+
+import "errors"
+
+const (
+	// pgUniqueViolation is the SQLSTATE postgres reports for violated unique constraints.
+	pgUniqueViolation = "23505"
+	// sqliteConstraintUnique is the extended result code of sqlite for violated unique constraints.
+	sqliteConstraintUnique = 2067
+)
+
+// IsUniqueViolation reports whether err was caused by
+// violating a unique constraint, on postgres or sqlite.
+func IsUniqueViolation(err error) bool {
+	var pgErr interface{ SQLState() string }
+	if errors.As(err, &pgErr) {
+		return pgErr.SQLState() == pgUniqueViolation
+	}
+
+	var sqliteErr interface{ Code() int }
+	if errors.As(err, &sqliteErr) {
+		return sqliteErr.Code() == sqliteConstraintUnique
+	}
+
+	return false
+}
@@ -0,0 +1,50 @@
+package db
+
+import (
+	"context"
+
+	"github.com/benchkram/errz"
+	"github.com/go-gormigrate/gormigrate/v2"
+)
+
+func (db *database) Ping(ctx context.Context) (err error) {
+	defer errz.Recover(&err)
+
+	if db.gorm == nil {
+		return ErrDatabaseNil
+	}
+
+	sqlDB, err := db.gorm.DB()
+	errz.Fatal(err)
+
+	return sqlDB.PingContext(ctx)
+}
+
+// Migrated fails with ErrMigrationsPending if a migration known to this
+// version didn't run, e.g. while another instance is still migrating.
+func (db *database) Migrated(ctx context.Context) (err error) {
+	defer errz.Recover(&err)
+
+	if db.gorm == nil {
+		return ErrDatabaseNil
+	}
+
+	var ids []string
+	err = db.gorm.WithContext(ctx).
+		Table(gormigrate.DefaultOptions.TableName).
+		Pluck(gormigrate.DefaultOptions.IDColumnName, &ids).Error
+	errz.Fatal(err)
+
+	ran := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		ran[id] = true
+	}
+
+	for _, m := range migrations() {
+		if !ran[m.ID] {
+			return ErrMigrationsPending
+		}
+	}
+
+	return nil
+}
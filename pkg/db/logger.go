@@ -0,0 +1,66 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// slowQuery is the duration after which queries are logged as warnings.
+const slowQuery = 200 * time.Millisecond
+
+// gormLogger writes the logs of gorm to the logger of the statement context.
+// The level is controlled by the logger, gorm's log mode is ignored.
+type gormLogger struct{}
+
+func (l gormLogger) LogMode(logger.LogLevel) logger.Interface {
+	return l
+}
+
+func (gormLogger) Info(ctx context.Context, msg string, args ...interface{}) {
+	log.Ctx(ctx).Info().Msg(fmt.Sprintf(msg, args...))
+}
+
+func (gormLogger) Warn(ctx context.Context, msg string, args ...interface{}) {
+	log.Ctx(ctx).Warn().Msg(fmt.Sprintf(msg, args...))
+}
+
+func (gormLogger) Error(ctx context.Context, msg string, args ...interface{}) {
+	log.Ctx(ctx).Error().Msg(fmt.Sprintf(msg, args...))
+}
+
+// Trace logs failed and slow queries, all others on trace level.
+func (gormLogger) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {
+	elapsed := time.Since(begin)
+
+	l := log.Ctx(ctx)
+	e := l.Trace()
+	msg := "Query executed."
+	switch {
+	case err != nil && !errors.Is(err, gorm.ErrRecordNotFound):
+		e = l.Error().Err(err)
+		msg = "Query failed."
+	case elapsed > slowQuery:
+		e = l.Warn()
+		msg = "Slow query."
+	}
+	if !e.Enabled() {
+		return
+	}
+
+	sql, rows := fc()
+	e.Str("sql", sql).
+		Int64("rows", rows).
+		Dur("elapsed", elapsed).
+		Msg(msg)
+}
+
+// gormConfig is the configuration all connections are opened with.
+func gormConfig() *gorm.Config {
+	return &gorm.Config{Logger: gormLogger{}}
+}
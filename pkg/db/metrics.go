@@ -0,0 +1,31 @@
+package db
+
+import (
+	"errors"
+
+	"github.com/benchkram/bobc/pkg/metrics"
+	"github.com/benchkram/errz"
+	"gorm.io/gorm"
+)
+
+// registerMetrics counts failed statements as postgres errors.
+// Records not found are expected by callers and not counted.
+func registerMetrics(gormDB *gorm.DB) (err error) {
+	defer errz.Recover(&err)
+
+	fn := func(tx *gorm.DB) {
+		if tx.Error != nil && !errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+			metrics.BackendErrors.WithLabelValues(metrics.Postgres).Inc()
+		}
+	}
+
+	cb := gormDB.Callback()
+	errz.Fatal(cb.Create().After("gorm:create").Register("metrics:create", fn))
+	errz.Fatal(cb.Query().After("gorm:query").Register("metrics:query", fn))
+	errz.Fatal(cb.Update().After("gorm:update").Register("metrics:update", fn))
+	errz.Fatal(cb.Delete().After("gorm:delete").Register("metrics:delete", fn))
+	errz.Fatal(cb.Row().After("gorm:row").Register("metrics:row", fn))
+	errz.Fatal(cb.Raw().After("gorm:raw").Register("metrics:raw", fn))
+
+	return nil
+}
@@ -1,24 +1,33 @@
 package db
 
 import (
-	"log"
+	"strings"
 	"time"
 
+	"github.com/benchkram/bobc/pkg/logging"
 	"github.com/benchkram/errz"
 	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
 	"gorm.io/gorm"
 )
 
 func (db *database) migrate() (err error) {
 	defer errz.Recover(&err)
 
-	log.Println("migrate db")
+	log.Info().Msg("Migrating database.")
 
 	if db.gorm == nil {
 		return ErrDatabaseNil
 	}
 
-	m := gormigrate.New(db.gorm, gormigrate.DefaultOptions, []*gormigrate.Migration{
+	m := gormigrate.New(db.gorm, gormigrate.DefaultOptions, migrations())
+	return m.Migrate()
+}
+
+// migrations of the schema in the order they are applied.
+func migrations() []*gormigrate.Migration {
+	return []*gormigrate.Migration{
 		{
 			ID: "202303201338",
 			Migrate: func(tx *gorm.DB) (err error) {
@@ -59,9 +68,337 @@ func (db *database) migrate() (err error) {
 				return nil
 			},
 		},
-	})
+		{
+			ID: "202610171000",
+			Migrate: func(tx *gorm.DB) (err error) {
+				defer errz.Recover(&err)
 
-	return m.Migrate()
+				if tx == nil {
+					return ErrDatabaseNil
+				}
+
+				// add tables for resumable uploads
+				err = tx.AutoMigrate(&Upload202610171000{}, &UploadPart202610171000{})
+				errz.Fatal(err)
+
+				return nil
+			},
+			Rollback: func(tx *gorm.DB) error {
+				return tx.Migrator().DropTable(&UploadPart202610171000{}, &Upload202610171000{})
+			},
+		},
+		{
+			ID: "202610171100",
+			Migrate: func(tx *gorm.DB) (err error) {
+				defer errz.Recover(&err)
+
+				if tx == nil {
+					return ErrDatabaseNil
+				}
+
+				// add direct column
+				err = tx.AutoMigrate(&Upload202610171100{})
+				errz.Fatal(err)
+
+				return nil
+			},
+			Rollback: func(tx *gorm.DB) error {
+				return tx.Migrator().DropColumn(&Upload202610171100{}, "Direct")
+			},
+		},
+		{
+			ID: "202610171200",
+			Migrate: func(tx *gorm.DB) (err error) {
+				defer errz.Recover(&err)
+
+				if tx == nil {
+					return ErrDatabaseNil
+				}
+
+				// add digest columns
+				err = tx.AutoMigrate(&Artifact202610171200{}, &Upload202610171200{})
+				errz.Fatal(err)
+
+				return nil
+			},
+			Rollback: func(tx *gorm.DB) (err error) {
+				defer errz.Recover(&err)
+
+				err = tx.Migrator().DropColumn(&Upload202610171200{}, "ExpectedDigest")
+				errz.Fatal(err)
+
+				return tx.Migrator().DropColumn(&Artifact202610171200{}, "Digest")
+			},
+		},
+		{
+			ID: "202610171300",
+			Migrate: func(tx *gorm.DB) (err error) {
+				defer errz.Recover(&err)
+
+				if tx == nil {
+					return ErrDatabaseNil
+				}
+
+				// add last_accessed_at column, existing
+				// artifacts count as accessed on creation
+				err = tx.AutoMigrate(&Artifact202610171300{})
+				errz.Fatal(err)
+
+				err = tx.Exec("UPDATE artifacts SET last_accessed_at = created_at WHERE last_accessed_at IS NULL").Error
+				errz.Fatal(err)
+
+				// add table for retention policies
+				err = tx.AutoMigrate(&RetentionPolicy202610171300{})
+				errz.Fatal(err)
+
+				return nil
+			},
+			Rollback: func(tx *gorm.DB) (err error) {
+				defer errz.Recover(&err)
+
+				err = tx.Migrator().DropTable(&RetentionPolicy202610171300{})
+				errz.Fatal(err)
+
+				return tx.Migrator().DropColumn(&Artifact202610171300{}, "LastAccessedAt")
+			},
+		},
+		{
+			ID: "202610171400",
+			Migrate: func(tx *gorm.DB) (err error) {
+				defer errz.Recover(&err)
+
+				if tx == nil {
+					return ErrDatabaseNil
+				}
+
+				// add table for quotas
+				err = tx.AutoMigrate(&Quota202610171400{})
+				errz.Fatal(err)
+
+				return nil
+			},
+			Rollback: func(tx *gorm.DB) error {
+				return tx.Migrator().DropTable(&Quota202610171400{})
+			},
+		},
+		{
+			ID: "202610171500",
+			Migrate: func(tx *gorm.DB) (err error) {
+				defer errz.Recover(&err)
+
+				if tx == nil {
+					return ErrDatabaseNil
+				}
+
+				// add table for api tokens
+				err = tx.AutoMigrate(&Token202610171500{})
+				errz.Fatal(err)
+
+				return nil
+			},
+			Rollback: func(tx *gorm.DB) error {
+				return tx.Migrator().DropTable(&Token202610171500{})
+			},
+		},
+		{
+			ID: "202610171600",
+			Migrate: func(tx *gorm.DB) (err error) {
+				defer errz.Recover(&err)
+
+				if tx == nil {
+					return ErrDatabaseNil
+				}
+
+				// add tables for users, organizations and their members
+				err = tx.AutoMigrate(&User202610171600{})
+				errz.Fatal(err)
+
+				err = tx.AutoMigrate(&Organization202610171600{})
+				errz.Fatal(err)
+
+				err = tx.AutoMigrate(&Member202610171600{})
+				errz.Fatal(err)
+
+				// add user_id column to tokens
+				err = tx.AutoMigrate(&Token202610171600{})
+				errz.Fatal(err)
+
+				// add organization_id column, existing projects
+				// are moved to the default organization
+				err = tx.AutoMigrate(&Project202610171600{})
+				errz.Fatal(err)
+
+				org := &Organization202610171600{
+					ID:          uuid.New().String(),
+					Name:        "default",
+					Description: "Projects created without an organization",
+				}
+				err = tx.Create(org).Error
+				errz.Fatal(err)
+
+				err = tx.Exec("UPDATE projects SET organization_id = ? WHERE organization_id IS NULL OR organization_id = ''", org.ID).Error
+				errz.Fatal(err)
+
+				return nil
+			},
+			Rollback: func(tx *gorm.DB) (err error) {
+				defer errz.Recover(&err)
+
+				err = tx.Migrator().DropColumn(&Project202610171600{}, "OrganizationID")
+				errz.Fatal(err)
+
+				err = tx.Migrator().DropColumn(&Token202610171600{}, "UserID")
+				errz.Fatal(err)
+
+				err = tx.Migrator().DropTable(&Member202610171600{})
+				errz.Fatal(err)
+
+				err = tx.Migrator().DropTable(&Organization202610171600{})
+				errz.Fatal(err)
+
+				return tx.Migrator().DropTable(&User202610171600{})
+			},
+		},
+		{
+			ID: "202610171700",
+			Migrate: func(tx *gorm.DB) (err error) {
+				defer errz.Recover(&err)
+
+				if tx == nil {
+					return ErrDatabaseNil
+				}
+
+				// add the unique path column, rows without
+				// path don't collide until they are filled.
+				err = tx.AutoMigrate(&Project202610171700{})
+				errz.Fatal(err)
+
+				orgs := []Organization202610171600{}
+				err = tx.Find(&orgs).Error
+				errz.Fatal(err)
+
+				orgNames := map[string]string{}
+				for _, o := range orgs {
+					orgNames[o.ID] = o.Name
+				}
+
+				projects := []Project202610171700{}
+				err = tx.Order("created_at").Find(&projects).Error
+				errz.Fatal(err)
+
+				// names weren't unique before, the oldest project keeps
+				// the name while later ones get their id appended.
+				taken := map[string]bool{}
+				for _, p := range projects {
+					name := p.Name
+					path := strings.ToLower(orgNames[p.OrganizationID] + "/" + name)
+					if taken[path] {
+						name = p.Name + "-" + p.ID[:8]
+						path = strings.ToLower(orgNames[p.OrganizationID] + "/" + name)
+						log.Warn().
+							Str(logging.FieldProjectID, p.ID).
+							Str("from", p.Name).
+							Str("to", name).
+							Msg("Project renamed, the name is taken.")
+					}
+					taken[path] = true
+
+					err = tx.Model(&Project202610171700{}).
+						Where("id = ?", p.ID).
+						Updates(map[string]interface{}{"name": name, "path": path}).Error
+					errz.Fatal(err)
+				}
+
+				return nil
+			},
+			Rollback: func(tx *gorm.DB) (err error) {
+				defer errz.Recover(&err)
+
+				err = tx.Migrator().DropIndex(&Project202610171700{}, "Path")
+				errz.Fatal(err)
+
+				return tx.Migrator().DropColumn(&Project202610171700{}, "Path")
+			},
+		},
+		{
+			ID: "202610171800",
+			Migrate: func(tx *gorm.DB) (err error) {
+				defer errz.Recover(&err)
+
+				if tx == nil {
+					return ErrDatabaseNil
+				}
+
+				// add public column, existing projects stay private
+				return tx.AutoMigrate(&Project202610171800{})
+			},
+			Rollback: func(tx *gorm.DB) (err error) {
+				defer errz.Recover(&err)
+
+				return tx.Migrator().DropColumn(&Project202610171800{}, "Public")
+			},
+		},
+		{
+			ID: "202610171900",
+			Migrate: func(tx *gorm.DB) (err error) {
+				defer errz.Recover(&err)
+
+				if tx == nil {
+					return ErrDatabaseNil
+				}
+
+				// add scope columns, existing artifacts
+				// and uploads belong to the default scope
+				err = tx.AutoMigrate(&Artifact202610171900{})
+				errz.Fatal(err)
+
+				return tx.AutoMigrate(&Upload202610171900{})
+			},
+			Rollback: func(tx *gorm.DB) (err error) {
+				defer errz.Recover(&err)
+
+				err = tx.Migrator().DropColumn(&Upload202610171900{}, "Scope")
+				errz.Fatal(err)
+
+				return tx.Migrator().DropColumn(&Artifact202610171900{}, "Scope")
+			},
+		},
+		{
+			ID: "202610172000",
+			Migrate: func(tx *gorm.DB) (err error) {
+				defer errz.Recover(&err)
+
+				if tx == nil {
+					return ErrDatabaseNil
+				}
+
+				// add table for replication high-water marks
+				err = tx.AutoMigrate(&ReplicationMark202610172000{})
+				errz.Fatal(err)
+
+				return nil
+			},
+			Rollback: func(tx *gorm.DB) error {
+				return tx.Migrator().DropTable(&ReplicationMark202610172000{})
+			},
+		},
+		{
+			ID: "202610172100",
+			Migrate: func(tx *gorm.DB) (err error) {
+				defer errz.Recover(&err)
+
+				if tx == nil {
+					return ErrDatabaseNil
+				}
+
+				// organization names are unique regardless of their case
+				return tx.Exec("CREATE UNIQUE INDEX idx_organizations_name_lower ON organizations (LOWER(name))").Error
+			},
+			Rollback: func(tx *gorm.DB) error {
+				return tx.Exec("DROP INDEX idx_organizations_name_lower").Error
+			},
+		},
+	}
 }
 
 type Artifact202303201338 struct {
@@ -107,3 +444,295 @@ type Artifact202304131325 struct {
 func (Artifact202304131325) TableName() string {
 	return "artifacts"
 }
+
+type Upload202610171000 struct {
+	ID              string                    `gorm:"primaryKey"`
+	ProjectID       string                    `gorm:"column:project_id;not null;index" sql:"type:uuid"`
+	ArtifactID      string                    `gorm:"column:artifact_id;not null"`
+	StorageID       string                    `gorm:"column:storage_id;not null"`
+	StorageUploadID string                    `gorm:"column:storage_upload_id;not null"`
+	ExpiresAt       time.Time                 `gorm:"column:expires_at;not null;index"`
+	Parts           []*UploadPart202610171000 `gorm:"foreignKey:UploadID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (Upload202610171000) TableName() string {
+	return "uploads"
+}
+
+type UploadPart202610171000 struct {
+	UploadID string `gorm:"primaryKey;column:upload_id"`
+	Number   int    `gorm:"primaryKey;column:number;autoIncrement:false"`
+	ETag     string `gorm:"column:etag;not null"`
+	Size     int64  `gorm:"column:size;not null"`
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (UploadPart202610171000) TableName() string {
+	return "upload_parts"
+}
+
+type Upload202610171100 struct {
+	ID              string    `gorm:"primaryKey"`
+	ProjectID       string    `gorm:"column:project_id;not null;index" sql:"type:uuid"`
+	ArtifactID      string    `gorm:"column:artifact_id;not null"`
+	StorageID       string    `gorm:"column:storage_id;not null"`
+	StorageUploadID string    `gorm:"column:storage_upload_id;not null"`
+	Direct          bool      `gorm:"column:direct;not null;default:false"`
+	ExpiresAt       time.Time `gorm:"column:expires_at;not null;index"`
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (Upload202610171100) TableName() string {
+	return "uploads"
+}
+
+type Artifact202610171200 struct {
+	ID         string `gorm:"primaryKey"`
+	ProjectID  string `gorm:"column:project_id;not null;index" sql:"type:uuid"`
+	ArtifactID string `gorm:"column:artifact_id;not null;index"`
+	Size       int    `gorm:"column:size;not null"`
+	Digest     string `gorm:"column:digest;not null;default:''"`
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time `sql:"index"`
+}
+
+func (Artifact202610171200) TableName() string {
+	return "artifacts"
+}
+
+type Upload202610171200 struct {
+	ID              string    `gorm:"primaryKey"`
+	ProjectID       string    `gorm:"column:project_id;not null;index" sql:"type:uuid"`
+	ArtifactID      string    `gorm:"column:artifact_id;not null"`
+	StorageID       string    `gorm:"column:storage_id;not null"`
+	StorageUploadID string    `gorm:"column:storage_upload_id;not null"`
+	Direct          bool      `gorm:"column:direct;not null;default:false"`
+	ExpectedDigest  string    `gorm:"column:expected_digest;not null;default:''"`
+	ExpiresAt       time.Time `gorm:"column:expires_at;not null;index"`
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (Upload202610171200) TableName() string {
+	return "uploads"
+}
+
+type Artifact202610171300 struct {
+	ID             string    `gorm:"primaryKey"`
+	ProjectID      string    `gorm:"column:project_id;not null;index" sql:"type:uuid"`
+	ArtifactID     string    `gorm:"column:artifact_id;not null;index"`
+	Size           int       `gorm:"column:size;not null"`
+	Digest         string    `gorm:"column:digest;not null;default:''"`
+	LastAccessedAt time.Time `gorm:"column:last_accessed_at;index"`
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time `sql:"index"`
+}
+
+func (Artifact202610171300) TableName() string {
+	return "artifacts"
+}
+
+type RetentionPolicy202610171300 struct {
+	ProjectID string `gorm:"primaryKey;column:project_id" sql:"type:uuid"`
+	MaxAge    int64  `gorm:"column:max_age;not null"`
+	MaxBytes  int64  `gorm:"column:max_bytes;not null"`
+	KeepLast  int    `gorm:"column:keep_last;not null"`
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (RetentionPolicy202610171300) TableName() string {
+	return "retention_policies"
+}
+
+type Quota202610171400 struct {
+	ProjectID    string `gorm:"primaryKey;column:project_id" sql:"type:uuid"`
+	MaxBytes     int64  `gorm:"column:max_bytes;not null"`
+	MaxArtifacts int    `gorm:"column:max_artifacts;not null"`
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (Quota202610171400) TableName() string {
+	return "quotas"
+}
+
+type Token202610171500 struct {
+	ID string `gorm:"primaryKey" sql:"type:uuid"`
+
+	Name      string     `gorm:"column:name;uniqueIndex;not null"`
+	Hash      string     `gorm:"column:hash;uniqueIndex;not null"`
+	Scope     string     `gorm:"column:scope;not null"`
+	ProjectID *string    `gorm:"column:project_id;index" sql:"type:uuid"`
+	ExpiresAt *time.Time `gorm:"column:expires_at"`
+
+	CreatedAt time.Time
+}
+
+func (Token202610171500) TableName() string {
+	return "tokens"
+}
+
+type User202610171600 struct {
+	ID   string `gorm:"primaryKey" sql:"type:uuid"`
+	Name string `gorm:"column:name;uniqueIndex;not null"`
+
+	CreatedAt time.Time
+}
+
+func (User202610171600) TableName() string {
+	return "users"
+}
+
+type Organization202610171600 struct {
+	ID          string `gorm:"primaryKey" sql:"type:uuid"`
+	Name        string `gorm:"column:name;uniqueIndex;not null"`
+	Description string `gorm:"column:description;not null"`
+
+	CreatedAt time.Time
+}
+
+func (Organization202610171600) TableName() string {
+	return "organizations"
+}
+
+type Member202610171600 struct {
+	OrganizationID string `gorm:"primaryKey;column:organization_id" sql:"type:uuid"`
+	UserID         string `gorm:"primaryKey;column:user_id;index" sql:"type:uuid"`
+	Role           string `gorm:"column:role;not null"`
+
+	CreatedAt time.Time
+}
+
+func (Member202610171600) TableName() string {
+	return "members"
+}
+
+type Token202610171600 struct {
+	ID string `gorm:"primaryKey" sql:"type:uuid"`
+
+	Name      string     `gorm:"column:name;uniqueIndex;not null"`
+	Hash      string     `gorm:"column:hash;uniqueIndex;not null"`
+	Scope     string     `gorm:"column:scope;not null"`
+	ProjectID *string    `gorm:"column:project_id;index" sql:"type:uuid"`
+	UserID    *string    `gorm:"column:user_id;index" sql:"type:uuid"`
+	ExpiresAt *time.Time `gorm:"column:expires_at"`
+
+	CreatedAt time.Time
+}
+
+func (Token202610171600) TableName() string {
+	return "tokens"
+}
+
+type Project202610171600 struct {
+	ID             string `gorm:"primaryKey;" sql:"type:uuid;"`
+	Name           string `gorm:"column:name;not null"`
+	Description    string `gorm:"column:description;not null"`
+	OrganizationID string `gorm:"column:organization_id;index" sql:"type:uuid"`
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time `sql:"index"`
+}
+
+func (Project202610171600) TableName() string {
+	return "projects"
+}
+
+type Project202610171700 struct {
+	ID             string `gorm:"primaryKey;" sql:"type:uuid;"`
+	Name           string `gorm:"column:name;not null"`
+	Description    string `gorm:"column:description;not null"`
+	OrganizationID string `gorm:"column:organization_id;index" sql:"type:uuid"`
+	Path           string `gorm:"column:path;uniqueIndex"`
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time `sql:"index"`
+}
+
+func (Project202610171700) TableName() string {
+	return "projects"
+}
+
+type Project202610171800 struct {
+	ID             string `gorm:"primaryKey;" sql:"type:uuid;"`
+	Name           string `gorm:"column:name;not null"`
+	Description    string `gorm:"column:description;not null"`
+	OrganizationID string `gorm:"column:organization_id;index" sql:"type:uuid"`
+	Path           string `gorm:"column:path;uniqueIndex"`
+	Public         bool   `gorm:"column:public;not null;default:false"`
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time `sql:"index"`
+}
+
+func (Project202610171800) TableName() string {
+	return "projects"
+}
+
+type Artifact202610171900 struct {
+	ID             string    `gorm:"primaryKey"`
+	ProjectID      string    `gorm:"column:project_id;not null;index" sql:"type:uuid"`
+	ArtifactID     string    `gorm:"column:artifact_id;not null;index"`
+	Size           int       `gorm:"column:size;not null"`
+	Scope          string    `gorm:"column:scope;not null;default:''"`
+	Digest         string    `gorm:"column:digest;not null;default:''"`
+	LastAccessedAt time.Time `gorm:"column:last_accessed_at;index"`
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time `sql:"index"`
+}
+
+func (Artifact202610171900) TableName() string {
+	return "artifacts"
+}
+
+type Upload202610171900 struct {
+	ID              string    `gorm:"primaryKey"`
+	ProjectID       string    `gorm:"column:project_id;not null;index" sql:"type:uuid"`
+	ArtifactID      string    `gorm:"column:artifact_id;not null"`
+	Scope           string    `gorm:"column:scope;not null;default:''"`
+	StorageID       string    `gorm:"column:storage_id;not null"`
+	StorageUploadID string    `gorm:"column:storage_upload_id;not null"`
+	Direct          bool      `gorm:"column:direct;not null;default:false"`
+	ExpectedDigest  string    `gorm:"column:expected_digest;not null;default:''"`
+	ExpiresAt       time.Time `gorm:"column:expires_at;not null;index"`
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (Upload202610171900) TableName() string {
+	return "uploads"
+}
+
+type ReplicationMark202610172000 struct {
+	Target    string    `gorm:"primaryKey;column:target"`
+	ProjectID string    `gorm:"primaryKey;column:project_id" sql:"type:uuid"`
+	Mark      time.Time `gorm:"column:mark;not null"`
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (ReplicationMark202610172000) TableName() string {
+	return "replication_marks"
+}
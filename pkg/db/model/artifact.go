@@ -8,6 +8,17 @@ type Artifact struct {
 	ArtifactID string `gorm:"column:artifact_id;not null;index"`
 	Size       int    `gorm:"column:size;not null"`
 
+	// Scope is the namespace the artifact was uploaded to, e.g. a branch.
+	// Empty for artifacts uploaded without scope.
+	Scope string `gorm:"column:scope;not null;default:''"`
+
+	// Digest is the hex encoded sha256 checksum of the payload.
+	// Empty for artifacts uploaded before digests were recorded.
+	Digest string `gorm:"column:digest;not null;default:''"`
+
+	// LastAccessedAt is the last time a download link was handed out.
+	LastAccessedAt time.Time `gorm:"column:last_accessed_at;index"`
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	DeletedAt *time.Time `sql:"index"`
@@ -0,0 +1,35 @@
+package model
+
+import "time"
+
+// Organization owns projects. Users get access to the
+// projects through their membership.
+type Organization struct {
+	ID string `gorm:"primaryKey" sql:"type:uuid"`
+
+	Name        string `gorm:"column:name;uniqueIndex;not null"`
+	Description string `gorm:"column:description;not null"`
+
+	CreatedAt time.Time
+}
+
+func (Organization) TableName() string {
+	return "organizations"
+}
+
+// Member gives a user a role in an organization.
+type Member struct {
+	OrganizationID string `gorm:"primaryKey;column:organization_id" sql:"type:uuid"`
+	UserID         string `gorm:"primaryKey;column:user_id;index" sql:"type:uuid"`
+
+	// Role is one of member, maintainer or owner
+	Role string `gorm:"column:role;not null"`
+
+	User *User `gorm:"foreignKey:UserID"`
+
+	CreatedAt time.Time
+}
+
+func (Member) TableName() string {
+	return "members"
+}
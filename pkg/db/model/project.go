@@ -9,6 +9,16 @@ type Project struct {
 	Description string      `gorm:"column:description;not null"`
 	Artifacts   []*Artifact `gorm:"foreignKey:ProjectID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 
+	OrganizationID string        `gorm:"column:organization_id;index" sql:"type:uuid"`
+	Organization   *Organization `gorm:"foreignKey:OrganizationID"`
+
+	// Path is the lowercased `organization/name` of the project, unique
+	// to prevent projects with names only differing in case.
+	Path string `gorm:"column:path;uniqueIndex"`
+
+	// Public projects can be read without credentials
+	Public bool `gorm:"column:public;not null;default:false"`
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	DeletedAt *time.Time `sql:"index"`
@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// Quota limits the storage a project can use.
+// A zero value disables the respective limit.
+type Quota struct {
+	ProjectID    string `gorm:"primaryKey;column:project_id" sql:"type:uuid"`
+	MaxBytes     int64  `gorm:"column:max_bytes;not null"`
+	MaxArtifacts int    `gorm:"column:max_artifacts;not null"`
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (Quota) TableName() string {
+	return "quotas"
+}
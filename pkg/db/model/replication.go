@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// ReplicationMark is the creation time of the newest artifact of a
+// project replicated to a target. Later runs start from there.
+type ReplicationMark struct {
+	Target    string    `gorm:"primaryKey;column:target"`
+	ProjectID string    `gorm:"primaryKey;column:project_id" sql:"type:uuid"`
+	Mark      time.Time `gorm:"column:mark;not null"`
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (ReplicationMark) TableName() string {
+	return "replication_marks"
+}
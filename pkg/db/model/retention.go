@@ -0,0 +1,25 @@
+package model
+
+import "time"
+
+// RetentionPolicy limits the artifacts kept for a project.
+// A zero value disables the respective limit.
+type RetentionPolicy struct {
+	ProjectID string `gorm:"primaryKey;column:project_id" sql:"type:uuid"`
+
+	// MaxAge in seconds since an artifact was created
+	MaxAge int64 `gorm:"column:max_age;not null"`
+
+	// MaxBytes is the maximum total size of all artifacts
+	MaxBytes int64 `gorm:"column:max_bytes;not null"`
+
+	// KeepLast is the number of most recently used artifacts to keep
+	KeepLast int `gorm:"column:keep_last;not null"`
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (RetentionPolicy) TableName() string {
+	return "retention_policies"
+}
@@ -0,0 +1,29 @@
+package model
+
+import "time"
+
+// Token is an API token. Only the sha256 hash of the secret is stored.
+type Token struct {
+	ID string `gorm:"primaryKey" sql:"type:uuid"`
+
+	Name string `gorm:"column:name;uniqueIndex;not null"`
+	Hash string `gorm:"column:hash;uniqueIndex;not null"`
+
+	// Scope is one of read, write or admin
+	Scope string `gorm:"column:scope;not null"`
+
+	// ProjectID restricts the token to a single project, nil allows all projects
+	ProjectID *string `gorm:"column:project_id;index" sql:"type:uuid"`
+
+	// UserID is set for tokens acting on behalf of a user
+	UserID *string `gorm:"column:user_id;index" sql:"type:uuid"`
+
+	// ExpiresAt is nil for tokens which never expire
+	ExpiresAt *time.Time `gorm:"column:expires_at"`
+
+	CreatedAt time.Time
+}
+
+func (Token) TableName() string {
+	return "tokens"
+}
@@ -0,0 +1,54 @@
+package model
+
+import "time"
+
+// Upload is an upload of an artifact in multiple parts which
+// has not been completed yet.
+type Upload struct {
+	ID         string `gorm:"primaryKey"`
+	ProjectID  string `gorm:"column:project_id;not null;index" sql:"type:uuid"`
+	ArtifactID string `gorm:"column:artifact_id;not null"`
+
+	// Scope the artifact is recorded in on completion.
+	Scope string `gorm:"column:scope;not null;default:''"`
+
+	// StorageID is the id the payload is stored under in the
+	// artifact store, becomes the id of the artifact on completion.
+	StorageID string `gorm:"column:storage_id;not null"`
+
+	// StorageUploadID identifies the multipart upload in the artifact store.
+	// Empty for direct uploads sent in a single request.
+	StorageUploadID string `gorm:"column:storage_upload_id;not null"`
+
+	// Direct is set when the client sends the payload directly
+	// to the artifact store using presigned links.
+	Direct bool `gorm:"column:direct;not null;default:false"`
+
+	// ExpectedDigest is the sha256 checksum the client announced
+	// for the payload, checked on completion. Optional.
+	ExpectedDigest string `gorm:"column:expected_digest;not null;default:''"`
+
+	ExpiresAt time.Time     `gorm:"column:expires_at;not null;index"`
+	Parts     []*UploadPart `gorm:"foreignKey:UploadID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (Upload) TableName() string {
+	return "uploads"
+}
+
+type UploadPart struct {
+	UploadID string `gorm:"primaryKey;column:upload_id"`
+	Number   int    `gorm:"primaryKey;column:number;autoIncrement:false"`
+	ETag     string `gorm:"column:etag;not null"`
+	Size     int64  `gorm:"column:size;not null"`
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (UploadPart) TableName() string {
+	return "upload_parts"
+}
@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+// User is a person or machine acting on behalf of organizations.
+type User struct {
+	ID string `gorm:"primaryKey" sql:"type:uuid"`
+
+	Name string `gorm:"column:name;uniqueIndex;not null"`
+
+	CreatedAt time.Time
+}
+
+func (User) TableName() string {
+	return "users"
+}
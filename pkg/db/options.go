@@ -25,6 +25,15 @@ func WithPostgres(host, port, user, password, databaseName string, useSSL bool)
 	}
 }
 
+// WithSQLite uses an embedded sqlite database stored at path.
+func WithSQLite(path string) Option {
+	return func(db *database) {
+		db.sqlitePath = path
+
+		db.dbType = SQLite
+	}
+}
+
 func WithConfig(config *Config) Option {
 	return func(db *database) {
 		db.host = config.Host
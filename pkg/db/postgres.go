@@ -3,13 +3,13 @@ package db
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
 	"github.com/avast/retry-go"
 	"github.com/benchkram/errz"
 	"github.com/jackc/pgx/v4"
+	"github.com/rs/zerolog/log"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
@@ -39,7 +39,7 @@ func (db *database) connectString() string {
 }
 
 func (db *database) connectGorm() (*gorm.DB, error) {
-	return gorm.Open(postgres.Open(db.connectString()), &gorm.Config{})
+	return gorm.Open(postgres.Open(db.connectString()), gormConfig())
 }
 
 // prepareDatabase for first time use.
@@ -66,7 +66,7 @@ func (db *database) withRawDB(fn func(*pgx.Conn) error) (err error) {
 	attempt := 1
 	err = retry.Do(
 		func() error {
-			log.Printf("Trying to connect to database: [attempt: %d]\n", attempt)
+			log.Info().Int("attempt", attempt).Msg("Connecting to database.")
 			attempt++
 
 			var err error
@@ -0,0 +1,32 @@
+package db
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/benchkram/errz"
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+)
+
+// sqlitePragmas are applied to every connection.
+// Foreign key enforcement is disabled by default in sqlite.
+const sqlitePragmas = "?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)"
+
+func (db *database) connectSQLite() (gormDB *gorm.DB, err error) {
+	defer errz.Recover(&err)
+
+	err = os.MkdirAll(filepath.Dir(db.sqlitePath), 0755)
+	errz.Fatal(err)
+
+	gormDB, err = gorm.Open(sqlite.Open(db.sqlitePath+sqlitePragmas), gormConfig())
+	errz.Fatal(err)
+
+	// sqlite allows only one writer at a time, serialize
+	// access instead of failing with "database is locked".
+	sqlDB, err := gormDB.DB()
+	errz.Fatal(err)
+	sqlDB.SetMaxOpenConns(1)
+
+	return gormDB, nil
+}
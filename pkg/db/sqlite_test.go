@@ -0,0 +1,93 @@
+package db
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/benchkram/bobc/pkg/db/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSQLiteMigrate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bobc-sqlite-*")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	db := New(WithSQLite(filepath.Join(dir, "bobc.db")))
+	err = db.Connect()
+	assert.Nil(t, err)
+
+	p := model.Project{
+		ID:   uuid.New().String(),
+		Name: "sqlite",
+	}
+	err = db.Gorm().Create(&p).Error
+	assert.Nil(t, err)
+
+	a := model.Artifact{
+		ID:         uuid.New().String(),
+		ProjectID:  p.ID,
+		ArtifactID: "abc",
+		Size:       750,
+	}
+	err = db.Gorm().Create(&a).Error
+	assert.Nil(t, err)
+
+	var count int64
+	err = db.Gorm().Model(&model.Artifact{}).Where("project_id = ?", p.ID).Count(&count).Error
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), count)
+
+	// migrations are idempotent
+	err = New(WithSQLite(filepath.Join(dir, "bobc.db"))).Connect()
+	assert.Nil(t, err)
+}
+
+func TestSQLiteHealth(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bobc-sqlite-*")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	db := New(WithSQLite(filepath.Join(dir, "bobc.db")))
+	err = db.Connect()
+	assert.Nil(t, err)
+
+	ctx := context.Background()
+	assert.Nil(t, db.Ping(ctx))
+	assert.Nil(t, db.Migrated(ctx))
+
+	// a migration missing, e.g. while an older instance is running
+	last := migrations()[len(migrations())-1]
+	err = db.Gorm().Exec("DELETE FROM migrations WHERE id = ?", last.ID).Error
+	assert.Nil(t, err)
+	assert.ErrorIs(t, db.Migrated(ctx), ErrMigrationsPending)
+
+	sqlDB, err := db.Gorm().DB()
+	assert.Nil(t, err)
+	assert.Nil(t, sqlDB.Close())
+	assert.NotNil(t, db.Ping(ctx))
+}
+
+func TestSQLiteUniqueViolation(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bobc-sqlite-*")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	db := New(WithSQLite(filepath.Join(dir, "bobc.db")))
+	err = db.Connect()
+	assert.Nil(t, err)
+
+	err = db.Gorm().Create(&model.Organization{ID: uuid.New().String(), Name: "benchkram"}).Error
+	assert.Nil(t, err)
+
+	// names are unique regardless of their case
+	err = db.Gorm().Create(&model.Organization{ID: uuid.New().String(), Name: "Benchkram"}).Error
+	assert.NotNil(t, err)
+	assert.True(t, IsUniqueViolation(err))
+
+	assert.False(t, IsUniqueViolation(ErrDatabaseNil))
+}
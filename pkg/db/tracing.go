@@ -0,0 +1,78 @@
+package db
+
+import (
+	"errors"
+
+	"github.com/benchkram/bobc/pkg/tracing"
+	"github.com/benchkram/errz"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.7.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+// spanKey stores the span of a statement on the statement itself.
+const spanKey = "tracing:span"
+
+// registerTracing creates a span for every statement executed with a
+// context holding a span, see gorm.DB.WithContext. Statements outside
+// of a trace, e.g. of metric scrapes, don't start a new trace.
+func registerTracing(gormDB *gorm.DB, dbType DatabaseType) (err error) {
+	defer errz.Recover(&err)
+
+	before := func(op string) func(tx *gorm.DB) {
+		return func(tx *gorm.DB) {
+			ctx := tx.Statement.Context
+			if ctx == nil || !trace.SpanContextFromContext(ctx).IsValid() {
+				return
+			}
+
+			name := "gorm." + op
+			if tx.Statement.Table != "" {
+				name += " " + tx.Statement.Table
+			}
+
+			ctx, span := tracing.Start(ctx, name,
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(semconv.DBSystemKey.String(string(dbType))),
+			)
+			tx.Statement.Context = ctx
+			tx.InstanceSet(spanKey, span)
+		}
+	}
+
+	after := func(tx *gorm.DB) {
+		v, ok := tx.InstanceGet(spanKey)
+		if !ok {
+			return
+		}
+		span := v.(trace.Span)
+
+		span.SetAttributes(
+			semconv.DBStatementKey.String(tx.Statement.SQL.String()),
+			attribute.Int64("db.rows_affected", tx.Statement.RowsAffected),
+		)
+
+		err := tx.Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			err = nil
+		}
+		tracing.End(span, &err)
+	}
+
+	cb := gormDB.Callback()
+	errz.Fatal(cb.Create().Before("gorm:create").Register("tracing:before_create", before("create")))
+	errz.Fatal(cb.Create().After("gorm:create").Register("tracing:after_create", after))
+	errz.Fatal(cb.Query().Before("gorm:query").Register("tracing:before_query", before("query")))
+	errz.Fatal(cb.Query().After("gorm:query").Register("tracing:after_query", after))
+	errz.Fatal(cb.Update().Before("gorm:update").Register("tracing:before_update", before("update")))
+	errz.Fatal(cb.Update().After("gorm:update").Register("tracing:after_update", after))
+	errz.Fatal(cb.Delete().Before("gorm:delete").Register("tracing:before_delete", before("delete")))
+	errz.Fatal(cb.Delete().After("gorm:delete").Register("tracing:after_delete", after))
+	errz.Fatal(cb.Row().Before("gorm:row").Register("tracing:before_row", before("row")))
+	errz.Fatal(cb.Row().After("gorm:row").Register("tracing:after_row", after))
+	errz.Fatal(cb.Raw().Before("gorm:raw").Register("tracing:before_raw", before("raw")))
+	errz.Fatal(cb.Raw().After("gorm:raw").Register("tracing:after_raw", after))
+
+	return nil
+}
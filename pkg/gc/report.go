@@ -0,0 +1,56 @@
+package gc
+
+import (
+	"fmt"
+	"io"
+)
+
+// Report is the result of reconciling the artifacts recorded
+// in the database with the objects in the artifact store.
+type Report struct {
+	// Applied is set when the inconsistencies found have been
+	// removed. Otherwise they are only reported (dry run).
+	Applied bool
+
+	// OrphanObjects are ids of objects in the artifact
+	// store which don't belong to any artifact.
+	OrphanObjects []string
+
+	// MissingObjects are artifacts whose payload
+	// is missing in the artifact store.
+	MissingObjects []Artifact
+}
+
+// Artifact identifies an artifact recorded in the database.
+type Artifact struct {
+	// ID the payload is stored under in the artifact store
+	ID string
+
+	ProjectID  string
+	ArtifactID string
+}
+
+// Empty reports if no inconsistencies have been found.
+func (r *Report) Empty() bool {
+	return len(r.OrphanObjects) == 0 && len(r.MissingObjects) == 0
+}
+
+func (r *Report) Summary() string {
+	action := "found"
+	if r.Applied {
+		action = "removed"
+	}
+	return fmt.Sprintf("%s %d orphaned objects and %d artifacts with missing objects",
+		action, len(r.OrphanObjects), len(r.MissingObjects))
+}
+
+// Print writes the inconsistencies found to w.
+func (r *Report) Print(w io.Writer) {
+	for _, id := range r.OrphanObjects {
+		fmt.Fprintf(w, "orphaned object: %s\n", id)
+	}
+	for _, a := range r.MissingObjects {
+		fmt.Fprintf(w, "missing object: %s [projectId: %s, artifactId: %s]\n", a.ID, a.ProjectID, a.ArtifactID)
+	}
+	fmt.Fprintln(w, r.Summary())
+}
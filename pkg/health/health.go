@@ -0,0 +1,100 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/benchkram/bobc/restserver/generated"
+)
+
+// Status of a dependency or of the server as a whole.
+type Status string
+
+const (
+	StatusUp   Status = "up"
+	StatusDown Status = "down"
+)
+
+// Check probes a dependency of the server, e.g. the database.
+type Check struct {
+	Name string
+	Fn   func(ctx context.Context) error
+}
+
+// Result of a single check.
+type Result struct {
+	Name    string
+	Status  Status
+	Latency time.Duration
+	Error   string
+}
+
+// Report on the readiness of the server. It's up when all checks are up.
+type Report struct {
+	Status Status
+	Checks []Result
+}
+
+// Run runs all checks in parallel, each is canceled after timeout.
+// Results are reported in the order of checks.
+func Run(ctx context.Context, timeout time.Duration, checks ...Check) *Report {
+	r := &Report{
+		Status: StatusUp,
+		Checks: make([]Result, len(checks)),
+	}
+
+	var wg sync.WaitGroup
+	for i, c := range checks {
+		wg.Add(1)
+		go func(i int, c Check) {
+			defer wg.Done()
+
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			start := time.Now()
+			err := c.Fn(ctx)
+
+			r.Checks[i] = Result{
+				Name:    c.Name,
+				Status:  StatusUp,
+				Latency: time.Since(start),
+			}
+			if err != nil {
+				r.Checks[i].Status = StatusDown
+				r.Checks[i].Error = err.Error()
+			}
+		}(i, c)
+	}
+	wg.Wait()
+
+	for _, c := range r.Checks {
+		if c.Status != StatusUp {
+			r.Status = StatusDown
+		}
+	}
+
+	return r
+}
+
+func (r *Report) ToRestType() generated.Health {
+	checks := make([]generated.HealthCheck, 0, len(r.Checks))
+	for _, c := range r.Checks {
+		check := generated.HealthCheck{
+			Name:      c.Name,
+			Status:    string(c.Status),
+			LatencyMs: float64(c.Latency) / float64(time.Millisecond),
+		}
+		if c.Error != "" {
+			msg := c.Error
+			check.Error = &msg
+		}
+		checks = append(checks, check)
+	}
+
+	return generated.Health{
+		Status: string(r.Status),
+		Checks: &checks,
+	}
+}
@@ -0,0 +1,60 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun(t *testing.T) {
+	up := Check{Name: "database", Fn: func(context.Context) error { return nil }}
+	down := Check{Name: "s3", Fn: func(context.Context) error { return fmt.Errorf("connection refused") }}
+	slow := Check{Name: "slow", Fn: func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}}
+
+	r := Run(context.Background(), time.Second, up)
+	assert.Equal(t, StatusUp, r.Status)
+	require.Len(t, r.Checks, 1)
+	assert.Equal(t, Result{Name: "database", Status: StatusUp, Latency: r.Checks[0].Latency}, r.Checks[0])
+
+	r = Run(context.Background(), 10*time.Millisecond, up, down, slow)
+	assert.Equal(t, StatusDown, r.Status)
+	require.Len(t, r.Checks, 3)
+	assert.Equal(t, "database", r.Checks[0].Name)
+	assert.Equal(t, StatusUp, r.Checks[0].Status)
+	assert.Equal(t, "s3", r.Checks[1].Name)
+	assert.Equal(t, StatusDown, r.Checks[1].Status)
+	assert.Equal(t, "connection refused", r.Checks[1].Error)
+	assert.Equal(t, StatusDown, r.Checks[2].Status)
+	assert.Equal(t, context.DeadlineExceeded.Error(), r.Checks[2].Error)
+	assert.GreaterOrEqual(t, r.Checks[2].Latency, 10*time.Millisecond)
+}
+
+func TestToRestType(t *testing.T) {
+	r := &Report{
+		Status: StatusDown,
+		Checks: []Result{
+			{Name: "database", Status: StatusDown, Latency: 1500 * time.Microsecond, Error: "connection refused"},
+			{Name: "s3", Status: StatusDown, Latency: 2 * time.Millisecond, Error: "access denied"},
+		},
+	}
+
+	h := r.ToRestType()
+	assert.Equal(t, "down", h.Status)
+	require.NotNil(t, h.Checks)
+	require.Len(t, *h.Checks, 2)
+
+	checks := *h.Checks
+	assert.Equal(t, "database", checks[0].Name)
+	assert.Equal(t, 1.5, checks[0].LatencyMs)
+	require.NotNil(t, checks[0].Error)
+	assert.Equal(t, "connection refused", *checks[0].Error)
+	require.NotNil(t, checks[1].Error)
+	assert.Equal(t, "access denied", *checks[1].Error)
+}
@@ -0,0 +1,127 @@
+package localstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/benchkram/bobc/pkg/tracing"
+	"github.com/benchkram/errz"
+)
+
+func (r *Repository) CreateArtifact(ctx context.Context, id string, src io.Reader) (err error) {
+	_, span := tracing.Start(ctx, "localstore.CreateArtifact")
+	defer tracing.End(span, &err)
+
+	return r.write(id, src)
+}
+
+// write stores the content of src under id. Data is written to a temporary
+// file next to its final location, synced and then atomically renamed so that
+// readers never observe a partially written artifact.
+func (r *Repository) write(id string, src io.Reader) (err error) {
+	defer errz.Recover(&err)
+
+	dst, err := r.path(id)
+	errz.Fatal(err)
+
+	dir := filepath.Dir(dst)
+	err = os.MkdirAll(dir, 0755)
+	errz.Fatal(err)
+
+	tmp, err := os.CreateTemp(dir, "."+id+".tmp-*")
+	errz.Fatal(err)
+
+	committed := false
+	defer func() {
+		if !committed {
+			tmp.Close()
+			os.Remove(tmp.Name())
+		}
+	}()
+
+	_, err = io.Copy(tmp, src)
+	errz.Fatal(err)
+
+	err = tmp.Sync()
+	errz.Fatal(err)
+
+	err = tmp.Close()
+	errz.Fatal(err)
+
+	err = os.Rename(tmp.Name(), dst)
+	errz.Fatal(err)
+	committed = true
+
+	// persist the rename itself
+	err = syncDir(dir)
+	errz.Fatal(err)
+
+	return nil
+}
+
+func (r *Repository) DeleteArtifact(ctx context.Context, id string) (err error) {
+	defer errz.Recover(&err)
+
+	p, err := r.path(id)
+	errz.Fatal(err)
+
+	err = os.Remove(p)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		errz.Fatal(err)
+	}
+
+	return nil
+}
+
+// Artifact returns a download link to the artifact served by bobc itself.
+// The link is signed and only valid for a limited time span.
+func (r *Repository) Artifact(ctx context.Context, id string) (addr *url.URL, err error) {
+	defer errz.Recover(&err)
+
+	_, err = r.path(id)
+	errz.Fatal(err)
+
+	expires := time.Now().Add(r.linkExpiry).Unix()
+
+	q := make(url.Values)
+	q.Set("expires", strconv.FormatInt(expires, 10))
+	q.Set("signature", r.sign(id, expires))
+
+	u := *r.baseURL
+	u.Path = path.Join(u.Path, "/api/download", id)
+	u.RawQuery = q.Encode()
+
+	return &u, nil
+}
+
+// Open the artifact's payload for reading.
+func (r *Repository) Open(id string) (_ *os.File, err error) {
+	defer errz.Recover(&err)
+
+	p, err := r.path(id)
+	errz.Fatal(err)
+
+	return os.Open(p)
+}
+
+// ReadArtifact opens the payload of the artifact id for reading.
+func (r *Repository) ReadArtifact(ctx context.Context, id string) (io.ReadCloser, error) {
+	return r.Open(id)
+}
+
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+
+	return d.Sync()
+}
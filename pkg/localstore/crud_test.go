@@ -0,0 +1,67 @@
+package localstore
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArtifactLifecycle(t *testing.T) {
+	ctx := context.Background()
+
+	dir, err := ioutil.TempDir("", "bobc-localstore-*")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	baseURL, err := url.Parse("http://localhost:8100")
+	assert.Nil(t, err)
+
+	r := New(filepath.Join(dir, "artifacts"), WithBaseURL(baseURL))
+
+	id := uuid.New().String()
+	err = r.CreateArtifact(ctx, id, bytes.NewReader(make([]byte, 750)))
+	assert.Nil(t, err)
+
+	// artifact is sharded and no temporary files are left behind
+	entries, err := os.ReadDir(filepath.Join(dir, "artifacts", id[0:2], id[2:4]))
+	assert.Nil(t, err)
+	assert.Len(t, entries, 1)
+	assert.Equal(t, id, entries[0].Name())
+
+	link, err := r.Artifact(ctx, id)
+	assert.Nil(t, err)
+	assert.Equal(t, "/api/download/"+id, link.Path)
+
+	expires, err := strconv.ParseInt(link.Query().Get("expires"), 10, 64)
+	assert.Nil(t, err)
+	assert.Nil(t, r.VerifyLink(id, expires, link.Query().Get("signature")))
+	assert.ErrorIs(t, r.VerifyLink(id, expires+1, link.Query().Get("signature")), ErrInvalidSignature)
+
+	f, err := r.Open(id)
+	assert.Nil(t, err)
+	body, err := ioutil.ReadAll(f)
+	assert.Nil(t, err)
+	assert.Equal(t, 750, len(body))
+	f.Close()
+
+	err = r.DeleteArtifact(ctx, id)
+	assert.Nil(t, err)
+
+	_, err = r.Open(id)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestInvalidID(t *testing.T) {
+	r := New(os.TempDir())
+
+	_, err := r.Open("../../etc/passwd")
+	assert.ErrorIs(t, err, ErrInvalidID)
+}
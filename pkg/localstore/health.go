@@ -0,0 +1,22 @@
+package localstore
+
+import (
+	"context"
+	"os"
+
+	"github.com/benchkram/errz"
+)
+
+// Ping checks that artifacts can be written to the storage directory.
+func (r *Repository) Ping(ctx context.Context) (err error) {
+	defer errz.Recover(&err)
+
+	err = os.MkdirAll(r.dir, 0755)
+	errz.Fatal(err)
+
+	f, err := os.CreateTemp(r.dir, ".ping-*")
+	errz.Fatal(err)
+	defer os.Remove(f.Name())
+
+	return f.Close()
+}
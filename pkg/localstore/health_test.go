@@ -0,0 +1,32 @@
+package localstore
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPing(t *testing.T) {
+	ctx := context.Background()
+
+	dir, err := ioutil.TempDir("", "bobc-localstore-*")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	r := New(filepath.Join(dir, "artifacts"))
+	assert.Nil(t, r.Ping(ctx))
+
+	// no leftovers
+	entries, err := os.ReadDir(filepath.Join(dir, "artifacts"))
+	assert.Nil(t, err)
+	assert.Len(t, entries, 0)
+
+	// the directory can't be created below a file
+	file := filepath.Join(dir, "file")
+	assert.Nil(t, os.WriteFile(file, nil, 0644))
+	assert.NotNil(t, New(filepath.Join(file, "artifacts")).Ping(ctx))
+}
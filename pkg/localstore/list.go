@@ -0,0 +1,49 @@
+package localstore
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// ListArtifacts calls fn for every artifact stored. Temporary files and
+// parts of uploads in progress are skipped.
+func (r *Repository) ListArtifacts(ctx context.Context, fn func(id string, modified time.Time) error) error {
+	err := filepath.WalkDir(r.dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			if p == filepath.Join(r.dir, uploadsDir) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		id := d.Name()
+		if strings.HasPrefix(id, ".") {
+			return nil
+		}
+		if expected, err := r.path(id); err != nil || expected != p {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		return fn(id, info.ModTime())
+	})
+	if errors.Is(err, os.ErrNotExist) {
+		// nothing stored yet
+		return nil
+	}
+
+	return err
+}
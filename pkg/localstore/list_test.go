@@ -0,0 +1,49 @@
+package localstore
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListArtifacts(t *testing.T) {
+	ctx := context.Background()
+
+	dir, err := ioutil.TempDir("", "bobc-localstore-*")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	r := New(filepath.Join(dir, "artifacts"))
+
+	// nothing stored yet
+	err = r.ListArtifacts(ctx, func(id string, modified time.Time) error {
+		t.Fatalf("unexpected artifact %s", id)
+		return nil
+	})
+	assert.Nil(t, err)
+
+	id := uuid.New().String()
+	err = r.CreateArtifact(ctx, id, bytes.NewReader(make([]byte, 750)))
+	assert.Nil(t, err)
+
+	// parts of uploads in progress are not listed
+	uploadID, err := r.NewMultipartUpload(ctx, uuid.New().String())
+	assert.Nil(t, err)
+	_, err = r.PutPart(ctx, id, uploadID, 1, bytes.NewReader(make([]byte, 10)), 10)
+	assert.Nil(t, err)
+
+	ids := []string{}
+	err = r.ListArtifacts(ctx, func(id string, modified time.Time) error {
+		ids = append(ids, id)
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{id}, ids)
+}
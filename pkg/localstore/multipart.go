@@ -0,0 +1,130 @@
+package localstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/benchkram/bobc/pkg/checksum"
+	"github.com/benchkram/bobc/pkg/tracing"
+	"github.com/benchkram/errz"
+	"github.com/google/uuid"
+)
+
+var ErrUploadNotFound = fmt.Errorf("upload not found")
+
+// uploadsDir holds the parts of multipart uploads in progress.
+// Hidden to not be confused with an artifact shard.
+const uploadsDir = ".uploads"
+
+// NewMultipartUpload starts an upload of the artifact id in multiple parts.
+func (r *Repository) NewMultipartUpload(ctx context.Context, id string) (uploadID string, err error) {
+	defer errz.Recover(&err)
+
+	_, err = r.path(id)
+	errz.Fatal(err)
+
+	uploadID = uuid.New().String()
+
+	err = os.MkdirAll(r.uploadPath(uploadID), 0755)
+	errz.Fatal(err)
+
+	return uploadID, nil
+}
+
+// PutPart stores a single part of a multipart upload. Uploading a part
+// with the same number again replaces the previous one.
+func (r *Repository) PutPart(ctx context.Context, id, uploadID string, number int, src io.Reader, size int64) (etag string, err error) {
+	_, span := tracing.Start(ctx, "localstore.PutPart")
+	defer tracing.End(span, &err)
+	defer errz.Recover(&err)
+
+	dir, err := r.existingUploadPath(uploadID)
+	errz.Fatal(err)
+
+	tmp, err := os.CreateTemp(dir, ".part-*")
+	errz.Fatal(err)
+
+	committed := false
+	defer func() {
+		if !committed {
+			tmp.Close()
+			os.Remove(tmp.Name())
+		}
+	}()
+
+	cr := checksum.NewReader(src)
+	_, err = io.Copy(tmp, cr)
+	errz.Fatal(err)
+
+	if cr.Size() != size {
+		errz.Fatal(io.ErrUnexpectedEOF)
+	}
+
+	err = tmp.Close()
+	errz.Fatal(err)
+
+	err = os.Rename(tmp.Name(), filepath.Join(dir, strconv.Itoa(number)))
+	errz.Fatal(err)
+	committed = true
+
+	return cr.Sum(), nil
+}
+
+// CompleteMultipartUpload assembles the artifact from its parts.
+// etags must be ordered by part number, starting with part 1.
+func (r *Repository) CompleteMultipartUpload(ctx context.Context, id, uploadID string, etags []string) (err error) {
+	_, span := tracing.Start(ctx, "localstore.CompleteMultipartUpload")
+	defer tracing.End(span, &err)
+	defer errz.Recover(&err)
+
+	dir, err := r.existingUploadPath(uploadID)
+	errz.Fatal(err)
+
+	readers := make([]io.Reader, 0, len(etags))
+	for i := range etags {
+		f, err := os.Open(filepath.Join(dir, strconv.Itoa(i+1)))
+		errz.Fatal(err)
+		defer f.Close()
+
+		readers = append(readers, f)
+	}
+
+	err = r.write(id, io.MultiReader(readers...))
+	errz.Fatal(err)
+
+	return os.RemoveAll(dir)
+}
+
+func (r *Repository) AbortMultipartUpload(ctx context.Context, id, uploadID string) (err error) {
+	defer errz.Recover(&err)
+
+	dir, err := r.existingUploadPath(uploadID)
+	errz.Fatal(err)
+
+	return os.RemoveAll(dir)
+}
+
+func (r *Repository) uploadPath(uploadID string) string {
+	return filepath.Join(r.dir, uploadsDir, uploadID)
+}
+
+func (r *Repository) existingUploadPath(uploadID string) (string, error) {
+	if _, err := uuid.Parse(uploadID); err != nil {
+		return "", ErrUploadNotFound
+	}
+
+	dir := r.uploadPath(uploadID)
+	_, err := os.Stat(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", ErrUploadNotFound
+	} else if err != nil {
+		return "", err
+	}
+
+	return dir, nil
+}
@@ -0,0 +1,45 @@
+package localstore
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultipartUpload(t *testing.T) {
+	ctx := context.Background()
+
+	dir, err := ioutil.TempDir("", "bobc-localstore-*")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	r := New(dir)
+
+	id := uuid.New().String()
+	uploadID, err := r.NewMultipartUpload(ctx, id)
+	assert.Nil(t, err)
+
+	// parts are assembled by number, not by arrival
+	etag2, err := r.PutPart(ctx, id, uploadID, 2, bytes.NewReader([]byte("world")), 5)
+	assert.Nil(t, err)
+	etag1, err := r.PutPart(ctx, id, uploadID, 1, bytes.NewReader([]byte("hello ")), 6)
+	assert.Nil(t, err)
+
+	err = r.CompleteMultipartUpload(ctx, id, uploadID, []string{etag1, etag2})
+	assert.Nil(t, err)
+
+	f, err := r.Open(id)
+	assert.Nil(t, err)
+	body, err := ioutil.ReadAll(f)
+	assert.Nil(t, err)
+	assert.Equal(t, "hello world", string(body))
+	f.Close()
+
+	err = r.AbortMultipartUpload(ctx, id, uploadID)
+	assert.ErrorIs(t, err, ErrUploadNotFound)
+}
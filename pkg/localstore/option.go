@@ -0,0 +1,27 @@
+package localstore
+
+import (
+	"net/url"
+	"time"
+)
+
+type Option func(r *Repository)
+
+// WithBaseURL sets the public address of bobc used in download links.
+func WithBaseURL(u *url.URL) Option {
+	return func(r *Repository) {
+		r.baseURL = u
+	}
+}
+
+func WithSigningKey(key []byte) Option {
+	return func(r *Repository) {
+		r.signingKey = key
+	}
+}
+
+func WithLinkExpiry(d time.Duration) Option {
+	return func(r *Repository) {
+		r.linkExpiry = d
+	}
+}
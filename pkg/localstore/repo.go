@@ -0,0 +1,67 @@
+package localstore
+
+import (
+	"crypto/rand"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidID        = fmt.Errorf("invalid artifact id")
+	ErrInvalidSignature = fmt.Errorf("invalid signature")
+	ErrLinkExpired      = fmt.Errorf("link expired")
+)
+
+// Repository stores artifacts on the local filesystem. It is meant for
+// single-node deployments which can't or don't want to run an object store.
+//
+// Artifacts are sharded into subdirectories using the first four characters
+// of their id, e.g. `ab/cd/abcd1234-...`, to keep directories small.
+type Repository struct {
+	// dir is the root directory artifacts are stored in
+	dir string
+
+	// baseURL is the address under which bobc is reachable
+	// by clients. Used to create download links.
+	baseURL *url.URL
+
+	// signingKey is used to sign download links
+	signingKey []byte
+
+	// linkExpiry is the time span a download link stays valid
+	linkExpiry time.Duration
+}
+
+func New(dir string, opts ...Option) *Repository {
+	r := &Repository{
+		dir:        dir,
+		baseURL:    &url.URL{Scheme: "http", Host: "localhost:8100"},
+		linkExpiry: 10 * time.Minute,
+	}
+
+	for _, opt := range opts {
+		if opt != nil {
+			opt(r)
+		}
+	}
+
+	// without a configured key links stay valid only
+	// as long as the process is running.
+	if len(r.signingKey) == 0 {
+		r.signingKey = make([]byte, 32)
+		_, _ = rand.Read(r.signingKey)
+	}
+
+	return r
+}
+
+// path returns the location of an artifact on disk.
+func (r *Repository) path(id string) (string, error) {
+	if len(id) < 4 || strings.ContainsAny(id, `/\.`) {
+		return "", ErrInvalidID
+	}
+	return filepath.Join(r.dir, id[0:2], id[2:4], id), nil
+}
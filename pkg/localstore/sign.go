@@ -0,0 +1,34 @@
+package localstore
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"time"
+)
+
+// sign computes the signature of a download link for id
+// which is valid until expires (unix seconds).
+func (r *Repository) sign(id string, expires int64) string {
+	mac := hmac.New(sha256.New, r.signingKey)
+	mac.Write([]byte(id))
+	mac.Write([]byte{0})
+	mac.Write([]byte(strconv.FormatInt(expires, 10)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyLink checks that a download link has been
+// signed by this repository and is not yet expired.
+func (r *Repository) VerifyLink(id string, expires int64, signature string) error {
+	expected := r.sign(id, expires)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return ErrInvalidSignature
+	}
+
+	if time.Now().Unix() > expires {
+		return ErrLinkExpired
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package logging
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/benchkram/errz"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+var ErrInvalidFormat = fmt.Errorf("invalid log format")
+
+// Formats log lines are written in.
+const (
+	FormatConsole = "console"
+	FormatJSON    = "json"
+)
+
+// Field names shared by all log lines.
+const (
+	FieldRequestID  = "request_id"
+	FieldTraceID    = "trace_id"
+	FieldProjectID  = "project_id"
+	FieldProject    = "project"
+	FieldArtifactID = "artifact_id"
+	FieldScope      = "scope"
+	FieldSubject    = "subject"
+)
+
+func init() {
+	_ = Setup(zerolog.LevelInfoValue, FormatConsole)
+}
+
+// Setup replaces the global logger of github.com/rs/zerolog/log by one
+// writing lines of level and above to stderr in format. The global logger
+// is also used by zerolog.Ctx for contexts without a logger.
+func Setup(level, format string) (err error) {
+	defer errz.Recover(&err)
+
+	lvl, err := zerolog.ParseLevel(level)
+	errz.Fatal(err)
+
+	var w io.Writer = &redactor{w: stderr{}}
+	switch format {
+	case FormatConsole:
+		w = zerolog.ConsoleWriter{Out: w, TimeFormat: time.RFC3339, NoColor: !terminal()}
+	case FormatJSON:
+	default:
+		return ErrInvalidFormat
+	}
+
+	log.Logger = zerolog.New(w).Level(lvl).With().Timestamp().Logger()
+	zerolog.DefaultContextLogger = &log.Logger
+
+	return nil
+}
+
+// stderr writes to the current os.Stderr, which can be replaced
+// after the logger was set up, e.g. to capture output in tests.
+type stderr struct{}
+
+func (stderr) Write(p []byte) (int, error) {
+	return os.Stderr.Write(p)
+}
+
+// terminal reports whether stderr is a terminal, colors are omitted otherwise.
+func terminal() bool {
+	fi, err := os.Stderr.Stat()
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,35 @@
+package logging
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetup(t *testing.T) {
+	defer func() { _ = Setup(zerolog.LevelInfoValue, FormatConsole) }()
+
+	assert.NoError(t, Setup(zerolog.LevelDebugValue, FormatJSON))
+	assert.ErrorIs(t, Setup(zerolog.LevelDebugValue, "xml"), ErrInvalidFormat)
+	assert.Error(t, Setup("verbose", FormatJSON))
+}
+
+func TestRedact(t *testing.T) {
+	defer func() { secrets = nil }()
+
+	Redact("", "s3cr3t", `pa"ss`)
+
+	buf := &bytes.Buffer{}
+	logger := zerolog.New(&redactor{w: buf})
+
+	logger.Info().
+		Str("dsn", "host=db password=s3cr3t").
+		Str("key", `pa"ss`).
+		Msg("Connecting.")
+
+	assert.NotContains(t, buf.String(), "s3cr3t")
+	assert.NotContains(t, buf.String(), `pa\"ss`)
+	assert.Equal(t, `{"level":"info","dsn":"host=db password=[REDACTED]","key":"[REDACTED]","message":"Connecting."}`+"\n", buf.String())
+}
@@ -0,0 +1,58 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"sync"
+)
+
+// Redacted replaces secrets in log lines.
+const Redacted = "[REDACTED]"
+
+var (
+	secretsMu sync.RWMutex
+	secrets   [][]byte
+)
+
+// Redact registers secrets, e.g. passwords and api keys, which are
+// replaced by Redacted in all lines written by the global logger.
+// Empty secrets are ignored.
+func Redact(s ...string) {
+	secretsMu.Lock()
+	defer secretsMu.Unlock()
+
+	for _, secret := range s {
+		if secret == "" {
+			continue
+		}
+		secrets = append(secrets, []byte(secret))
+
+		// json lines contain the escaped secret
+		escaped, _ := json.Marshal(secret)
+		escaped = escaped[1 : len(escaped)-1]
+		if string(escaped) != secret {
+			secrets = append(secrets, escaped)
+		}
+	}
+}
+
+// redactor removes the registered secrets from lines written to w.
+type redactor struct {
+	w io.Writer
+}
+
+func (r *redactor) Write(p []byte) (int, error) {
+	secretsMu.RLock()
+	line := p
+	for _, secret := range secrets {
+		line = bytes.ReplaceAll(line, secret, []byte(Redacted))
+	}
+	secretsMu.RUnlock()
+
+	_, err := r.w.Write(line)
+	if err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -0,0 +1,117 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "bobc"
+
+// Backends reported with BackendErrors.
+const (
+	S3       = "s3"
+	Postgres = "postgres"
+)
+
+// Presign operations reported with Presigns.
+const (
+	PresignGet     = "get"
+	PresignPut     = "put"
+	PresignPutPart = "put_part"
+)
+
+var registry = prometheus.NewRegistry()
+
+var (
+	// RequestDuration observes the latency of requests by route, the
+	// path pattern as registered, not the path of the request.
+	RequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "Latency of http requests by route.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "code"})
+
+	// ArtifactExists counts artifact existence checks by result, `hit` or `miss`.
+	ArtifactExists = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "artifact_exists_total",
+		Help:      "Artifact existence checks by result.",
+	}, []string{"result"})
+
+	Uploads = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "uploads_total",
+		Help:      "Artifacts uploaded.",
+	})
+
+	UploadBytes = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "upload_bytes_total",
+		Help:      "Bytes of artifacts uploaded.",
+	})
+
+	// Presigns counts links signed by the artifact store by operation.
+	Presigns = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "presign_total",
+		Help:      "Presigned links by operation.",
+	}, []string{"operation"})
+
+	// BackendErrors counts failed calls to the artifact store and the database.
+	BackendErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "backend_errors_total",
+		Help:      "Failed calls to storage backends.",
+	}, []string{"backend"})
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		RequestDuration,
+		ArtifactExists,
+		Uploads,
+		UploadBytes,
+		Presigns,
+		BackendErrors,
+	)
+}
+
+// Exists records the result of an artifact existence check.
+func Exists(hit bool) {
+	if hit {
+		ArtifactExists.WithLabelValues("hit").Inc()
+	} else {
+		ArtifactExists.WithLabelValues("miss").Inc()
+	}
+}
+
+// ExistsBatch records the results of checking multiple artifacts at once.
+func ExistsBatch(hits, misses int) {
+	ArtifactExists.WithLabelValues("hit").Add(float64(hits))
+	ArtifactExists.WithLabelValues("miss").Add(float64(misses))
+}
+
+// Upload records an uploaded artifact of size bytes.
+func Upload(size int) {
+	Uploads.Inc()
+	UploadBytes.Add(float64(size))
+}
+
+// Error records a failed call to backend when *err is set.
+// Meant to be deferred, before errz.Recover to see recovered errors.
+func Error(backend string, err *error) {
+	if *err != nil {
+		BackendErrors.WithLabelValues(backend).Inc()
+	}
+}
+
+// Handler serves the metrics in the prometheus text format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
@@ -0,0 +1,57 @@
+package metrics
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/benchkram/bobc/pkg/quota"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestError(t *testing.T) {
+	before := testutil.ToFloat64(BackendErrors.WithLabelValues(S3))
+
+	err := fmt.Errorf("connection refused")
+	Error(S3, &err)
+
+	err = nil
+	Error(S3, &err)
+
+	assert.Equal(t, before+1, testutil.ToFloat64(BackendErrors.WithLabelValues(S3)))
+}
+
+func TestUsage(t *testing.T) {
+	c := &usageCollector{usage: func() (map[string]quota.Usage, error) {
+		return map[string]quota.Usage{
+			"acme/app": {Bytes: 1024, Artifacts: 3},
+		}, nil
+	}}
+
+	expected := `
+# HELP bobc_project_artifacts Number of artifacts stored by project.
+# TYPE bobc_project_artifacts gauge
+bobc_project_artifacts{project="acme/app"} 3
+# HELP bobc_project_stored_bytes Bytes of artifacts stored by project.
+# TYPE bobc_project_stored_bytes gauge
+bobc_project_stored_bytes{project="acme/app"} 1024
+`
+	err := testutil.CollectAndCompare(c, strings.NewReader(expected))
+	assert.Nil(t, err)
+}
+
+func TestHandler(t *testing.T) {
+	Upload(42)
+
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+
+	body, err := ioutil.ReadAll(rec.Body)
+	require.Nil(t, err)
+	assert.Contains(t, string(body), "bobc_uploads_total")
+	assert.Contains(t, string(body), "bobc_upload_bytes_total")
+}
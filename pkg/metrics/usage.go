@@ -0,0 +1,53 @@
+package metrics
+
+import (
+	"github.com/benchkram/bobc/pkg/quota"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rs/zerolog/log"
+)
+
+// UsageFunc returns the storage used by each project, keyed by project path.
+type UsageFunc func() (map[string]quota.Usage, error)
+
+var (
+	storedBytesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "project", "stored_bytes"),
+		"Bytes of artifacts stored by project.",
+		[]string{"project"}, nil,
+	)
+	artifactsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "project", "artifacts"),
+		"Number of artifacts stored by project.",
+		[]string{"project"}, nil,
+	)
+)
+
+// usageCollector reports the usage of projects as gauges. It's queried
+// on every scrape, so deleted projects disappear from the metrics.
+type usageCollector struct {
+	usage UsageFunc
+}
+
+func (c *usageCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- storedBytesDesc
+	ch <- artifactsDesc
+}
+
+func (c *usageCollector) Collect(ch chan<- prometheus.Metric) {
+	usage, err := c.usage()
+	if err != nil {
+		log.Error().Err(err).Msg("Collecting project usage failed.")
+		return
+	}
+
+	for project, u := range usage {
+		ch <- prometheus.MustNewConstMetric(storedBytesDesc, prometheus.GaugeValue, float64(u.Bytes), project)
+		ch <- prometheus.MustNewConstMetric(artifactsDesc, prometheus.GaugeValue, float64(u.Artifacts), project)
+	}
+}
+
+// RegisterUsage reports the usage returned by fn per project.
+// Must be called only once.
+func RegisterUsage(fn UsageFunc) {
+	registry.MustRegister(&usageCollector{usage: fn})
+}
@@ -0,0 +1,18 @@
+package oidc
+
+import "fmt"
+
+var (
+	ErrNoKeys     = fmt.Errorf("key set contains no signing keys")
+	ErrInvalidKey = fmt.Errorf("invalid key")
+	ErrUnknownKey = fmt.Errorf("unknown key")
+
+	ErrInvalidRule = fmt.Errorf("rule requires claims, a project and a valid scope")
+
+	ErrInvalidToken    = fmt.Errorf("invalid token")
+	ErrInvalidIssuer   = fmt.Errorf("invalid issuer")
+	ErrInvalidAudience = fmt.Errorf("invalid audience")
+	ErrExpired         = fmt.Errorf("token expired")
+	ErrNotYetValid     = fmt.Errorf("token not yet valid")
+	ErrNoMatchingRule  = fmt.Errorf("no rule matches the token")
+)
@@ -0,0 +1,248 @@
+package oidc
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/benchkram/errz"
+)
+
+// minRefreshInterval limits how often a remote key set is fetched
+// when a token is signed with an unknown key.
+const minRefreshInterval = time.Minute
+
+// maxKeySetSize limits the size of a fetched key set.
+const maxKeySetSize = 1 << 20
+
+// KeySet holds the public keys of an identity provider by key id.
+// Keys loaded from a url are fetched again when a token refers to an
+// unknown key, so the provider can rotate its keys.
+type KeySet struct {
+	// source is a file path or a http(s) url
+	source string
+
+	mu   sync.Mutex
+	keys map[string]crypto.PublicKey
+	// refreshedAt is the time of the last fetch, successful or not.
+	refreshedAt time.Time
+}
+
+// NewKeySet loads a JSON Web Key Set (RFC 7517) from a file or url.
+func NewKeySet(source string) (_ *KeySet, err error) {
+	defer errz.Recover(&err)
+
+	ks := &KeySet{source: source}
+
+	ks.keys, err = ks.fetch()
+	errz.Fatal(err)
+	ks.refreshedAt = time.Now()
+
+	return ks, nil
+}
+
+// Key returns the key with id kid. An empty kid is only
+// accepted when the set contains exactly one key.
+//
+// The set is fetched again at most once per minRefreshInterval.
+// Concurrent lookups of unknown keys don't wait for the fetch,
+// they fail till the new set is in place.
+func (ks *KeySet) Key(kid string) (crypto.PublicKey, error) {
+	ks.mu.Lock()
+	key, ok := ks.lookup(kid)
+	if ok {
+		ks.mu.Unlock()
+		return key, nil
+	}
+
+	if !ks.remote() || time.Since(ks.refreshedAt) < minRefreshInterval {
+		ks.mu.Unlock()
+		return nil, ErrUnknownKey
+	}
+	ks.refreshedAt = time.Now()
+	ks.mu.Unlock()
+
+	keys, err := ks.fetch()
+	if err != nil {
+		return nil, err
+	}
+
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	ks.keys = keys
+
+	key, ok = ks.lookup(kid)
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+
+	return key, nil
+}
+
+func (ks *KeySet) lookup(kid string) (crypto.PublicKey, bool) {
+	if kid == "" && len(ks.keys) == 1 {
+		for _, key := range ks.keys {
+			return key, true
+		}
+	}
+
+	key, ok := ks.keys[kid]
+	return key, ok
+}
+
+func (ks *KeySet) remote() bool {
+	return strings.HasPrefix(ks.source, "http://") || strings.HasPrefix(ks.source, "https://")
+}
+
+// fetch reads the key set from its source.
+func (ks *KeySet) fetch() (_ map[string]crypto.PublicKey, err error) {
+	defer errz.Recover(&err)
+
+	var r io.ReadCloser
+	if ks.remote() {
+		client := http.Client{Timeout: 10 * time.Second}
+		resp, err := client.Get(ks.source)
+		errz.Fatal(err)
+
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			errz.Fatal(fmt.Errorf("fetching key set from %s: %s", ks.source, resp.Status))
+		}
+		r = resp.Body
+	} else {
+		f, err := os.Open(ks.source)
+		errz.Fatal(err)
+		r = f
+	}
+	defer r.Close()
+
+	data, err := ioutil.ReadAll(io.LimitReader(r, maxKeySetSize))
+	errz.Fatal(err)
+
+	return ParseKeySet(data)
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+
+	// RSA
+	N string `json:"n"`
+	E string `json:"e"`
+
+	// EC
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// ParseKeySet parses the RSA and EC signing keys of a JSON Web Key Set.
+// Keys of other types or for encryption are ignored.
+func ParseKeySet(data []byte) (_ map[string]crypto.PublicKey, err error) {
+	defer errz.Recover(&err)
+
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	err = json.Unmarshal(data, &set)
+	errz.Fatal(err)
+
+	keys := map[string]crypto.PublicKey{}
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+
+		var key crypto.PublicKey
+		switch k.Kty {
+		case "RSA":
+			key, err = k.rsa()
+		case "EC":
+			key, err = k.ecdsa()
+		default:
+			continue
+		}
+		if err != nil {
+			errz.Fatal(fmt.Errorf("key %q: %w", k.Kid, err))
+		}
+
+		keys[k.Kid] = key
+	}
+
+	if len(keys) == 0 {
+		errz.Fatal(ErrNoKeys)
+	}
+
+	return keys, nil
+}
+
+func (k *jwk) rsa() (*rsa.PublicKey, error) {
+	n, err := decodeInt(k.N)
+	if err != nil {
+		return nil, err
+	}
+	e, err := decodeInt(k.E)
+	if err != nil {
+		return nil, err
+	}
+	if !e.IsInt64() || e.Int64() > 1<<31-1 {
+		return nil, ErrInvalidKey
+	}
+
+	return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+}
+
+func (k *jwk) ecdsa() (*ecdsa.PublicKey, error) {
+	var curve elliptic.Curve
+	switch k.Crv {
+	case "P-256":
+		curve = elliptic.P256()
+	case "P-384":
+		curve = elliptic.P384()
+	case "P-521":
+		curve = elliptic.P521()
+	default:
+		return nil, ErrInvalidKey
+	}
+
+	x, err := decodeInt(k.X)
+	if err != nil {
+		return nil, err
+	}
+	y, err := decodeInt(k.Y)
+	if err != nil {
+		return nil, err
+	}
+	if !curve.IsOnCurve(x, y) {
+		return nil, ErrInvalidKey
+	}
+
+	return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+}
+
+func decodeInt(s string) (*big.Int, error) {
+	if s == "" {
+		return nil, ErrInvalidKey
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidKey
+	}
+
+	return new(big.Int).SetBytes(b), nil
+}
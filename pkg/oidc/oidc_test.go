@@ -0,0 +1,217 @@
+package oidc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/benchkram/bobc/pkg/principal"
+	"github.com/benchkram/bobc/pkg/token"
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	issuer   = "https://ci.example.com"
+	audience = "bobc"
+)
+
+type projects map[string]uuid.UUID
+
+func (p projects) ProjectIDByName(_ context.Context, name string) (uuid.UUID, error) {
+	id, ok := p[name]
+	if !ok {
+		return uuid.Nil, ErrInvalidRule
+	}
+	return id, nil
+}
+
+func encode(i *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(i.Bytes())
+}
+
+// keySet writes a JWKS holding the public keys to a temporary file.
+func keySet(t *testing.T, rsaKey *rsa.PrivateKey, ecKey *ecdsa.PrivateKey) string {
+	set := map[string]interface{}{
+		"keys": []map[string]string{
+			{"kty": "RSA", "kid": "rsa", "use": "sig", "n": encode(rsaKey.N), "e": encode(big.NewInt(int64(rsaKey.E)))},
+			{"kty": "EC", "kid": "ec", "crv": "P-256", "x": encode(ecKey.X), "y": encode(ecKey.Y)},
+		},
+	}
+	data, err := json.Marshal(set)
+	require.Nil(t, err)
+
+	file := filepath.Join(t.TempDir(), "jwks.json")
+	err = os.WriteFile(file, data, 0600)
+	require.Nil(t, err)
+
+	return file
+}
+
+func sign(t *testing.T, method jwt.SigningMethod, kid string, key interface{}, claims jwt.MapClaims) string {
+	tok := jwt.NewWithClaims(method, claims)
+	tok.Header["kid"] = kid
+
+	raw, err := tok.SignedString(key)
+	require.Nil(t, err)
+
+	return raw
+}
+
+func TestVerify(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+
+	keys, err := NewKeySet(keySet(t, rsaKey, ecKey))
+	require.Nil(t, err)
+
+	bob := uuid.New()
+	rules := []Rule{
+		{Claims: map[string]string{"repository": "benchkram/bob", "ref": "refs/heads/main"}, Project: "bob", Scope: token.ScopeWrite},
+		{Claims: map[string]string{"repository": "benchkram/*"}, Project: AllProjects, Scope: token.ScopeRead},
+		{Claims: map[string]string{"repository": "benchkram/bob"}, Project: "missing", Scope: token.ScopeAdmin},
+	}
+	v := New(issuer, audience, keys, rules, projects{"bob": bob})
+
+	now := time.Now()
+	claims := func(modify func(c jwt.MapClaims)) jwt.MapClaims {
+		c := jwt.MapClaims{
+			"iss":        issuer,
+			"aud":        audience,
+			"sub":        "repo:benchkram/bob:ref:refs/heads/main",
+			"exp":        now.Add(time.Hour).Unix(),
+			"iat":        now.Unix(),
+			"repository": "benchkram/bob",
+			"ref":        "refs/heads/main",
+		}
+		if modify != nil {
+			modify(c)
+		}
+		return c
+	}
+
+	p, err := v.Verify(context.Background(), sign(t, jwt.SigningMethodRS256, "rsa", rsaKey, claims(nil)))
+	require.Nil(t, err)
+	assert.Equal(t, "oidc/repo:benchkram/bob:ref:refs/heads/main", p.Subject)
+	assert.Equal(t, []principal.Grant{
+		{ProjectID: bob, Scope: token.ScopeWrite},
+		{ProjectID: uuid.Nil, Scope: token.ScopeRead},
+	}, p.Grants)
+
+	p, err = v.Verify(context.Background(), sign(t, jwt.SigningMethodES256, "ec", ecKey, claims(func(c jwt.MapClaims) {
+		c["ref"] = "refs/heads/feature"
+		c["aud"] = []string{"other", audience}
+	})))
+	require.Nil(t, err)
+	assert.True(t, p.Allows(bob, token.ScopeRead))
+	assert.False(t, p.Allows(bob, token.ScopeWrite))
+
+	invalid := []struct {
+		name string
+		raw  string
+		err  error
+	}{
+		{"unknown signer", sign(t, jwt.SigningMethodRS256, "rsa", otherKey, claims(nil)), ErrInvalidToken},
+		{"unknown key", sign(t, jwt.SigningMethodRS256, "other", otherKey, claims(nil)), ErrInvalidToken},
+		{"symmetric", sign(t, jwt.SigningMethodHS256, "rsa", []byte("secret"), claims(nil)), ErrInvalidToken},
+		{"issuer", sign(t, jwt.SigningMethodRS256, "rsa", rsaKey, claims(func(c jwt.MapClaims) { c["iss"] = "https://evil.example.com" })), ErrInvalidIssuer},
+		{"audience", sign(t, jwt.SigningMethodRS256, "rsa", rsaKey, claims(func(c jwt.MapClaims) { c["aud"] = "other" })), ErrInvalidAudience},
+		{"expired", sign(t, jwt.SigningMethodRS256, "rsa", rsaKey, claims(func(c jwt.MapClaims) { c["exp"] = now.Add(-time.Hour).Unix() })), ErrExpired},
+		{"no expiry", sign(t, jwt.SigningMethodRS256, "rsa", rsaKey, claims(func(c jwt.MapClaims) { delete(c, "exp") })), ErrExpired},
+		{"not before", sign(t, jwt.SigningMethodRS256, "rsa", rsaKey, claims(func(c jwt.MapClaims) { c["nbf"] = now.Add(time.Hour).Unix() })), ErrNotYetValid},
+		{"no rule", sign(t, jwt.SigningMethodRS256, "rsa", rsaKey, claims(func(c jwt.MapClaims) { c["repository"] = "someone/else" })), ErrNoMatchingRule},
+	}
+	for _, test := range invalid {
+		_, err := v.Verify(context.Background(), test.raw)
+		assert.ErrorIs(t, err, test.err, test.name)
+	}
+}
+
+func TestLoadRules(t *testing.T) {
+	dir := t.TempDir()
+
+	file := filepath.Join(dir, "rules.yaml")
+	err := os.WriteFile(file, []byte(`
+rules:
+  - claims:
+      repository: benchkram/bob
+      ref: refs/heads/*
+    project: bob
+    scope: write
+`), 0600)
+	require.Nil(t, err)
+
+	rules, err := LoadRules(file)
+	require.Nil(t, err)
+	require.Len(t, rules, 1)
+	assert.Equal(t, "bob", rules[0].Project)
+	assert.Equal(t, token.ScopeWrite, rules[0].Scope)
+	assert.True(t, rules[0].Matches(map[string]interface{}{"repository": "benchkram/bob", "ref": "refs/heads/main"}))
+	assert.False(t, rules[0].Matches(map[string]interface{}{"repository": "benchkram/bob"}))
+
+	err = os.WriteFile(file, []byte("rules:\n  - claims: {repository: benchkram/bob}\n    project: bob\n    scope: owner\n"), 0600)
+	require.Nil(t, err)
+
+	_, err = LoadRules(file)
+	assert.ErrorIs(t, err, ErrInvalidRule)
+}
+
+func TestKeySetRefresh(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+
+	data, err := os.ReadFile(keySet(t, rsaKey, ecKey))
+	require.Nil(t, err)
+
+	var fetches int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&fetches, 1)
+		_, _ = w.Write(data)
+	}))
+	defer srv.Close()
+
+	keys, err := NewKeySet(srv.URL)
+	require.Nil(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&fetches))
+
+	_, err = keys.Key("rsa")
+	assert.Nil(t, err)
+
+	// unknown keys don't trigger a fetch within minRefreshInterval
+	for i := 0; i < 10; i++ {
+		_, err = keys.Key("unknown")
+		assert.ErrorIs(t, err, ErrUnknownKey)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&fetches))
+
+	// a failed fetch delays the next attempt as well
+	keys.refreshedAt = time.Now().Add(-minRefreshInterval)
+	srv.Close()
+	_, err = keys.Key("unknown")
+	assert.NotNil(t, err)
+	_, err = keys.Key("unknown")
+	assert.ErrorIs(t, err, ErrUnknownKey)
+
+	_, err = keys.Key("ec")
+	assert.Nil(t, err)
+}
@@ -0,0 +1,93 @@
+package oidc
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path"
+
+	"github.com/benchkram/bobc/pkg/token"
+	"github.com/benchkram/errz"
+	"gopkg.in/yaml.v3"
+)
+
+// AllProjects as project of a rule grants the scope on every project.
+const AllProjects = "*"
+
+// Rule grants a scope on a project to tokens whose claims match.
+type Rule struct {
+	// Claims to match, values are glob patterns as understood by path.Match.
+	// All claims must match for the rule to apply.
+	Claims map[string]string `yaml:"claims"`
+
+	// Project name or AllProjects
+	Project string `yaml:"project"`
+
+	Scope token.Scope `yaml:"scope"`
+}
+
+// Matches reports if all claims of the rule match the token's claims.
+// Non string claims are compared by their formatted value.
+func (r *Rule) Matches(claims map[string]interface{}) bool {
+	for name, pattern := range r.Claims {
+		v, ok := claims[name]
+		if !ok {
+			return false
+		}
+
+		s, ok := v.(string)
+		if !ok {
+			s = fmt.Sprint(v)
+		}
+
+		matched, err := path.Match(pattern, s)
+		if err != nil || !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// LoadRules reads the rule set from a yaml file of the form
+//
+//	rules:
+//	  - claims:
+//	      repository: benchkram/bob
+//	      ref: refs/heads/main
+//	    project: bob
+//	    scope: write
+func LoadRules(file string) (_ []Rule, err error) {
+	defer errz.Recover(&err)
+
+	data, err := ioutil.ReadFile(file)
+	errz.Fatal(err)
+
+	var config struct {
+		Rules []Rule `yaml:"rules"`
+	}
+	err = yaml.Unmarshal(data, &config)
+	errz.Fatal(err)
+
+	for i, r := range config.Rules {
+		err = r.validate()
+		if err != nil {
+			errz.Fatal(fmt.Errorf("rule %d: %w", i+1, err))
+		}
+	}
+
+	return config.Rules, nil
+}
+
+func (r *Rule) validate() error {
+	if len(r.Claims) == 0 {
+		return ErrInvalidRule
+	}
+	for _, pattern := range r.Claims {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return err
+		}
+	}
+	if r.Project == "" || !r.Scope.Valid() {
+		return ErrInvalidRule
+	}
+	return nil
+}
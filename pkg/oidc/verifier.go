@@ -0,0 +1,157 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"encoding/json"
+	"time"
+
+	"github.com/benchkram/bobc/pkg/principal"
+	"github.com/benchkram/errz"
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+)
+
+// leeway tolerates clock skew between bobc and the identity provider.
+const leeway = 30 * time.Second
+
+// algorithms accepted for signatures, all asymmetric.
+var algorithms = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+// Keys returns the public key to verify a signature.
+type Keys interface {
+	Key(kid string) (crypto.PublicKey, error)
+}
+
+// Projects resolves the project names used in rules.
+type Projects interface {
+	ProjectIDByName(ctx context.Context, name string) (uuid.UUID, error)
+}
+
+// Verifier validates JWTs issued by an OIDC provider and
+// maps their claims to grants through a rule set.
+type Verifier struct {
+	issuer   string
+	audience string
+
+	keys     Keys
+	rules    []Rule
+	projects Projects
+
+	now func() time.Time
+}
+
+func New(issuer, audience string, keys Keys, rules []Rule, projects Projects) *Verifier {
+	return &Verifier{
+		issuer:   issuer,
+		audience: audience,
+		keys:     keys,
+		rules:    rules,
+		projects: projects,
+		now:      time.Now,
+	}
+}
+
+// Verify checks signature, issuer, audience and lifetime of raw and
+// returns a principal holding the grants of all matching rules.
+// Tokens matching no rule are rejected.
+func (v *Verifier) Verify(ctx context.Context, raw string) (_ *principal.P, err error) {
+	defer errz.Recover(&err)
+
+	parser := jwt.Parser{
+		ValidMethods:         algorithms,
+		UseJSONNumber:        true,
+		SkipClaimsValidation: true,
+	}
+
+	claims := jwt.MapClaims{}
+	_, err = parser.ParseWithClaims(raw, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return v.keys.Key(kid)
+	})
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	err = v.validate(claims)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &principal.P{}
+	p.Subject = "oidc/" + stringClaim(claims, "sub")
+	p.Grants, err = v.grants(ctx, claims)
+	errz.Fatal(err)
+
+	if len(p.Grants) == 0 {
+		return nil, ErrNoMatchingRule
+	}
+
+	return p, nil
+}
+
+// validate checks the registered claims. Unlike jwt.MapClaims.Valid
+// an expiry is required.
+func (v *Verifier) validate(claims jwt.MapClaims) error {
+	if !claims.VerifyIssuer(v.issuer, true) {
+		return ErrInvalidIssuer
+	}
+	if !claims.VerifyAudience(v.audience, true) {
+		return ErrInvalidAudience
+	}
+
+	now := v.now()
+	if !claims.VerifyExpiresAt(now.Add(-leeway).Unix(), true) {
+		return ErrExpired
+	}
+	if !claims.VerifyNotBefore(now.Add(leeway).Unix(), false) {
+		return ErrNotYetValid
+	}
+	if !claims.VerifyIssuedAt(now.Add(leeway).Unix(), false) {
+		return ErrNotYetValid
+	}
+
+	return nil
+}
+
+// grants collects the grants of all rules matching claims.
+// Rules naming a project which doesn't exist are skipped.
+func (v *Verifier) grants(ctx context.Context, claims jwt.MapClaims) (_ []principal.Grant, err error) {
+	defer errz.Recover(&err)
+
+	// resolving project names is not restricted by the caller's grants
+	ctx = principal.NewContext(ctx, principal.Admin("oidc"))
+
+	values := map[string]interface{}{}
+	for name, value := range claims {
+		if n, ok := value.(json.Number); ok {
+			value = n.String()
+		}
+		values[name] = value
+	}
+
+	grants := []principal.Grant{}
+	for _, r := range v.rules {
+		if !r.Matches(values) {
+			continue
+		}
+
+		projectID := uuid.Nil
+		if r.Project != AllProjects {
+			projectID, err = v.projects.ProjectIDByName(ctx, r.Project)
+			if err != nil {
+				errz.Log(err)
+				continue
+			}
+		}
+
+		grants = append(grants, principal.Grant{ProjectID: projectID, Scope: r.Scope})
+	}
+
+	return grants, nil
+}
+
+func stringClaim(claims jwt.MapClaims, name string) string {
+	s, _ := claims[name].(string)
+	return s
+}
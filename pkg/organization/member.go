@@ -0,0 +1,79 @@
+package organization
+
+import (
+	"github.com/benchkram/bobc/pkg/db/model"
+	"github.com/benchkram/bobc/pkg/token"
+	"github.com/benchkram/bobc/restserver/generated"
+	"github.com/google/uuid"
+)
+
+// Role of a user in an organization. Each role includes the ones below it.
+type Role string
+
+const (
+	// RoleMember allows to download artifacts of the organization's projects
+	RoleMember Role = "member"
+
+	// RoleMaintainer allows to upload and delete artifacts
+	RoleMaintainer Role = "maintainer"
+
+	// RoleOwner allows to manage projects and members of the organization
+	RoleOwner Role = "owner"
+)
+
+// Scope returns the scope a role grants on the organization's projects.
+func (r Role) Scope() token.Scope {
+	switch r {
+	case RoleMember:
+		return token.ScopeRead
+	case RoleMaintainer:
+		return token.ScopeWrite
+	case RoleOwner:
+		return token.ScopeAdmin
+	default:
+		return ""
+	}
+}
+
+// Valid reports if r is a known role.
+func (r Role) Valid() bool {
+	return r.Scope().Valid()
+}
+
+// Member is a user with a role in an organization.
+type Member struct {
+	OrganizationID uuid.UUID
+	UserID         uuid.UUID
+
+	// UserName is only set when read from the database
+	UserName string
+
+	Role Role
+}
+
+func MemberFromDatabaseType(m *model.Member) *Member {
+	member := &Member{
+		OrganizationID: uuid.MustParse(m.OrganizationID),
+		UserID:         uuid.MustParse(m.UserID),
+		Role:           Role(m.Role),
+	}
+	if m.User != nil {
+		member.UserName = m.User.Name
+	}
+	return member
+}
+
+func (m *Member) ToDatabaseType() *model.Member {
+	return &model.Member{
+		OrganizationID: m.OrganizationID.String(),
+		UserID:         m.UserID.String(),
+		Role:           string(m.Role),
+	}
+}
+
+func (m *Member) ToRestType() generated.Member {
+	return generated.Member{
+		User: m.UserName,
+		Role: generated.Role(m.Role),
+	}
+}
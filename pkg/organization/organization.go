@@ -0,0 +1,58 @@
+package organization
+
+import (
+	"time"
+
+	"github.com/benchkram/bobc/pkg/db/model"
+	"github.com/benchkram/bobc/restserver/generated"
+	"github.com/google/uuid"
+)
+
+// DefaultName is the organization owning projects created without one.
+// It is created by the database migration.
+const DefaultName = "default"
+
+// O is an organization owning projects.
+type O struct {
+	ID          uuid.UUID
+	Name        string
+	Description string
+
+	CreatedAt time.Time
+}
+
+func New(name, description string) *O {
+	return &O{
+		ID:          uuid.New(),
+		Name:        name,
+		Description: description,
+		CreatedAt:   time.Now(),
+	}
+}
+
+func FromDatabaseType(m *model.Organization) *O {
+	return &O{
+		ID:          uuid.MustParse(m.ID),
+		Name:        m.Name,
+		Description: m.Description,
+		CreatedAt:   m.CreatedAt,
+	}
+}
+
+func (o *O) ToDatabaseType() *model.Organization {
+	return &model.Organization{
+		ID:          o.ID.String(),
+		Name:        o.Name,
+		Description: o.Description,
+		CreatedAt:   o.CreatedAt,
+	}
+}
+
+func (o *O) ToRestType() generated.Organization {
+	return generated.Organization{
+		Id:          o.ID.String(),
+		Name:        o.Name,
+		Description: o.Description,
+		CreatedAt:   o.CreatedAt,
+	}
+}
@@ -0,0 +1,71 @@
+package orgrepo
+
+import (
+	"github.com/benchkram/bobc/pkg/db/model"
+	"github.com/benchkram/bobc/pkg/organization"
+	"github.com/benchkram/errz"
+	"github.com/google/uuid"
+)
+
+// MemberSet adds a member to an organization or changes its role.
+func (r *Repository) MemberSet(m *organization.Member) (err error) {
+	defer errz.Recover(&err)
+
+	result := r.db.Gorm().Model(&model.Member{}).
+		Where("organization_id = ? AND user_id = ?", m.OrganizationID.String(), m.UserID.String()).
+		Update("role", string(m.Role))
+	errz.Fatal(result.Error)
+
+	if result.RowsAffected > 0 {
+		return nil
+	}
+
+	err = r.db.Gorm().Create(m.ToDatabaseType()).Error
+	errz.Fatal(err)
+
+	return nil
+}
+
+func (r *Repository) MemberRemove(organizationID, userID uuid.UUID) (err error) {
+	defer errz.Recover(&err)
+
+	result := r.db.Gorm().
+		Where("organization_id = ? AND user_id = ?", organizationID.String(), userID.String()).
+		Delete(&model.Member{})
+	errz.Fatal(result.Error)
+
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// Members returns the members of an organization including their names.
+func (r *Repository) Members(organizationID uuid.UUID) (_ []*organization.Member, err error) {
+	defer errz.Recover(&err)
+
+	return r.members("organization_id = ?", organizationID.String())
+}
+
+// Memberships returns the memberships of a user.
+func (r *Repository) Memberships(userID uuid.UUID) (_ []*organization.Member, err error) {
+	defer errz.Recover(&err)
+
+	return r.members("user_id = ?", userID.String())
+}
+
+func (r *Repository) members(query string, args ...interface{}) (_ []*organization.Member, err error) {
+	defer errz.Recover(&err)
+
+	ms := []*model.Member{}
+	err = r.db.Gorm().Preload("User").Where(query, args...).Order("created_at").Find(&ms).Error
+	errz.Fatal(err)
+
+	members := []*organization.Member{}
+	for _, m := range ms {
+		members = append(members, organization.MemberFromDatabaseType(m))
+	}
+
+	return members, nil
+}
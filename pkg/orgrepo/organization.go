@@ -0,0 +1,88 @@
+package orgrepo
+
+import (
+	"github.com/benchkram/bobc/pkg/db"
+	"github.com/benchkram/bobc/pkg/db/model"
+	"github.com/benchkram/bobc/pkg/organization"
+	"github.com/benchkram/errz"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// OrganizationCreate stores a new organization.
+// Names are unique regardless of their case.
+func (r *Repository) OrganizationCreate(o *organization.O) (err error) {
+	defer errz.Recover(&err)
+
+	err = r.db.Gorm().Create(o.ToDatabaseType()).Error
+	if db.IsUniqueViolation(err) {
+		return ErrAlreadyExists
+	}
+	errz.Fatal(err)
+
+	return nil
+}
+
+func (r *Repository) Organizations() (_ []*organization.O, err error) {
+	defer errz.Recover(&err)
+
+	ms := []*model.Organization{}
+	err = r.db.Gorm().Order("name").Find(&ms).Error
+	errz.Fatal(err)
+
+	orgs := []*organization.O{}
+	for _, m := range ms {
+		orgs = append(orgs, organization.FromDatabaseType(m))
+	}
+
+	return orgs, nil
+}
+
+// OrganizationByName looks up an organization ignoring case,
+// like project paths do.
+func (r *Repository) OrganizationByName(name string) (_ *organization.O, err error) {
+	defer errz.Recover(&err)
+
+	m := &model.Organization{}
+	result := r.db.Gorm().Where("LOWER(name) = LOWER(?)", name).Find(m)
+	errz.Fatal(result.Error)
+
+	if result.RowsAffected == 0 {
+		return nil, ErrNotFound
+	}
+
+	return organization.FromDatabaseType(m), nil
+}
+
+// OrganizationDelete deletes an organization along with its members.
+// Organizations still owning projects are not deleted.
+func (r *Repository) OrganizationDelete(id uuid.UUID) (err error) {
+	defer errz.Recover(&err)
+
+	return r.db.Gorm().Transaction(func(tx *gorm.DB) error {
+		var count int64
+		err := tx.Model(&model.Project{}).Where("organization_id = ?", id.String()).Count(&count).Error
+		if err != nil {
+			return err
+		}
+
+		if count > 0 {
+			return ErrNotEmpty
+		}
+
+		err = tx.Where("organization_id = ?", id.String()).Delete(&model.Member{}).Error
+		if err != nil {
+			return err
+		}
+
+		result := tx.Delete(&model.Organization{ID: id.String()})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return ErrNotFound
+		}
+		return nil
+	})
+}
@@ -0,0 +1,24 @@
+package orgrepo
+
+import (
+	"fmt"
+
+	"github.com/benchkram/bobc/pkg/db"
+)
+
+var (
+	ErrNotFound      = fmt.Errorf("not found")
+	ErrAlreadyExists = fmt.Errorf("already exists")
+	ErrNotEmpty      = fmt.Errorf("organization owns projects")
+)
+
+// Repository stores users, organizations and their members.
+type Repository struct {
+	db db.Database
+}
+
+func New(db db.Database) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
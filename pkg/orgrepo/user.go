@@ -0,0 +1,97 @@
+package orgrepo
+
+import (
+	"github.com/benchkram/bobc/pkg/db/model"
+	"github.com/benchkram/bobc/pkg/user"
+	"github.com/benchkram/errz"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// UserCreate stores a new user. Names are unique regardless of their case.
+func (r *Repository) UserCreate(u *user.U) (err error) {
+	defer errz.Recover(&err)
+
+	var count int64
+	err = r.db.Gorm().Model(&model.User{}).Where("LOWER(name) = LOWER(?)", u.Name).Count(&count).Error
+	errz.Fatal(err)
+
+	if count > 0 {
+		return ErrAlreadyExists
+	}
+
+	err = r.db.Gorm().Create(u.ToDatabaseType()).Error
+	errz.Fatal(err)
+
+	return nil
+}
+
+func (r *Repository) Users() (_ []*user.U, err error) {
+	defer errz.Recover(&err)
+
+	ms := []*model.User{}
+	err = r.db.Gorm().Order("name").Find(&ms).Error
+	errz.Fatal(err)
+
+	users := []*user.U{}
+	for _, m := range ms {
+		users = append(users, user.FromDatabaseType(m))
+	}
+
+	return users, nil
+}
+
+func (r *Repository) User(id uuid.UUID) (_ *user.U, err error) {
+	defer errz.Recover(&err)
+
+	m := &model.User{}
+	result := r.db.Gorm().Where(&model.User{ID: id.String()}).Find(m)
+	errz.Fatal(result.Error)
+
+	if result.RowsAffected == 0 {
+		return nil, ErrNotFound
+	}
+
+	return user.FromDatabaseType(m), nil
+}
+
+func (r *Repository) UserByName(name string) (_ *user.U, err error) {
+	defer errz.Recover(&err)
+
+	m := &model.User{}
+	result := r.db.Gorm().Where(&model.User{Name: name}).Find(m)
+	errz.Fatal(result.Error)
+
+	if result.RowsAffected == 0 {
+		return nil, ErrNotFound
+	}
+
+	return user.FromDatabaseType(m), nil
+}
+
+// UserDelete deletes a user along with its memberships and api tokens.
+func (r *Repository) UserDelete(id uuid.UUID) (err error) {
+	defer errz.Recover(&err)
+
+	return r.db.Gorm().Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("user_id = ?", id.String()).Delete(&model.Member{}).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Where("user_id = ?", id.String()).Delete(&model.Token{}).Error
+		if err != nil {
+			return err
+		}
+
+		result := tx.Delete(&model.User{ID: id.String()})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return ErrNotFound
+		}
+		return nil
+	})
+}
@@ -0,0 +1,26 @@
+package periodic
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Run calls fn every interval till ctx is canceled.
+// Errors are logged and don't stop the loop.
+func Run(ctx context.Context, interval time.Duration, fn func() error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := fn(); err != nil {
+				log.Error().Err(err).Msg("Periodic task failed.")
+			}
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package principal
+
+import (
+	"context"
+
+	"github.com/benchkram/bobc/pkg/organization"
+	"github.com/benchkram/bobc/pkg/token"
+	"github.com/google/uuid"
+)
+
+// P is the authenticated caller of a request.
+type P struct {
+	// Subject identifies the caller in logs
+	Subject string
+
+	// TokenID of the api token used, uuid.Nil for other credentials
+	TokenID uuid.UUID
+
+	// Grants of the caller, any matching grant allows a request
+	Grants []Grant
+
+	// Anonymous is set for requests without credentials,
+	// they can only read public projects
+	Anonymous bool
+}
+
+// Grant gives a scope on a project.
+type Grant struct {
+	// OrganizationID restricts the grant to projects
+	// of an organization, uuid.Nil for all organizations
+	OrganizationID uuid.UUID
+
+	// ProjectID the scope is given on, uuid.Nil for all projects
+	ProjectID uuid.UUID
+
+	Scope token.Scope
+}
+
+// Admin returns a principal with full access.
+func Admin(subject string) *P {
+	return &P{
+		Subject: subject,
+		Grants:  []Grant{{Scope: token.ScopeAdmin}},
+	}
+}
+
+// Anonymous returns the principal of a request without credentials.
+func Anonymous() *P {
+	return &P{
+		Subject:   "anonymous",
+		Grants:    []Grant{},
+		Anonymous: true,
+	}
+}
+
+// FromToken returns the principal authenticated by an api token.
+func FromToken(t *token.T) *P {
+	return &P{
+		Subject: "token/" + t.Name,
+		TokenID: t.ID,
+		Grants:  []Grant{{ProjectID: t.ProjectID, Scope: t.Scope}},
+	}
+}
+
+// FromUserToken returns the principal authenticated by an api token
+// acting for a user. The token's scope is limited by the user's role
+// in each organization, it gets no access outside of them.
+func FromUserToken(t *token.T, userName string, memberships []*organization.Member) *P {
+	p := &P{
+		Subject: "user/" + userName,
+		TokenID: t.ID,
+		Grants:  []Grant{},
+	}
+
+	for _, m := range memberships {
+		scope := t.Scope
+		if !m.Role.Scope().Includes(scope) {
+			scope = m.Role.Scope()
+		}
+
+		p.Grants = append(p.Grants, Grant{
+			OrganizationID: m.OrganizationID,
+			ProjectID:      t.ProjectID,
+			Scope:          scope,
+		})
+	}
+
+	return p
+}
+
+// Allows reports if the principal has scope on the project.
+// Pass uuid.Nil for requests which don't target a single project.
+// Grants restricted to an organization are not considered, use AllowsIn.
+func (p *P) Allows(projectID uuid.UUID, scope token.Scope) bool {
+	return p.AllowsIn(uuid.Nil, projectID, scope)
+}
+
+// AllowsIn reports if the principal has scope on a project of an organization.
+// Pass uuid.Nil as projectID for requests on the organization itself.
+func (p *P) AllowsIn(organizationID, projectID uuid.UUID, scope token.Scope) bool {
+	for _, g := range p.Grants {
+		if g.OrganizationID != uuid.Nil && g.OrganizationID != organizationID {
+			continue
+		}
+		if g.ProjectID != uuid.Nil && g.ProjectID != projectID {
+			continue
+		}
+		if g.Scope.Includes(scope) {
+			return true
+		}
+	}
+	return false
+}
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx carrying p.
+func NewContext(ctx context.Context, p *P) context.Context {
+	return context.WithValue(ctx, contextKey{}, p)
+}
+
+// FromContext returns the principal carried by ctx, nil if there is none.
+func FromContext(ctx context.Context) *P {
+	p, _ := ctx.Value(contextKey{}).(*P)
+	return p
+}
+
+// OrganizationScoped reports if any grant is restricted to an organization.
+// Checks of such principals need to know the organization of a project.
+func (p *P) OrganizationScoped() bool {
+	for _, g := range p.Grants {
+		if g.OrganizationID != uuid.Nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,80 @@
+package principal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/benchkram/bobc/pkg/organization"
+	"github.com/benchkram/bobc/pkg/token"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllows(t *testing.T) {
+	projectID := uuid.New()
+
+	p := Admin("test")
+	assert.True(t, p.Allows(projectID, token.ScopeAdmin))
+	assert.True(t, p.Allows(uuid.Nil, token.ScopeAdmin))
+
+	p = &P{Grants: []Grant{
+		{ProjectID: projectID, Scope: token.ScopeWrite},
+		{Scope: token.ScopeRead},
+	}}
+	assert.True(t, p.Allows(projectID, token.ScopeWrite))
+	assert.True(t, p.Allows(uuid.New(), token.ScopeRead))
+	assert.False(t, p.Allows(uuid.New(), token.ScopeWrite))
+	assert.False(t, p.Allows(projectID, token.ScopeAdmin))
+
+	p = &P{}
+	assert.False(t, p.Allows(projectID, token.ScopeRead))
+}
+
+func TestContext(t *testing.T) {
+	assert.Nil(t, FromContext(context.Background()))
+
+	p := Admin("test")
+	assert.Equal(t, p, FromContext(NewContext(context.Background(), p)))
+}
+
+func TestFromUserToken(t *testing.T) {
+	org := uuid.New()
+	other := uuid.New()
+	projectID := uuid.New()
+
+	tok := &token.T{ID: uuid.New(), Name: "ci", Scope: token.ScopeWrite}
+	p := FromUserToken(tok, "alice", []*organization.Member{
+		{OrganizationID: org, Role: organization.RoleOwner},
+		{OrganizationID: other, Role: organization.RoleMember},
+	})
+	assert.Equal(t, "user/alice", p.Subject)
+	assert.True(t, p.OrganizationScoped())
+
+	// the token's scope caps the role
+	assert.True(t, p.AllowsIn(org, projectID, token.ScopeWrite))
+	assert.False(t, p.AllowsIn(org, projectID, token.ScopeAdmin))
+
+	// the role caps the token's scope
+	assert.True(t, p.AllowsIn(other, projectID, token.ScopeRead))
+	assert.False(t, p.AllowsIn(other, projectID, token.ScopeWrite))
+
+	// no access outside of the organizations
+	assert.False(t, p.AllowsIn(uuid.New(), projectID, token.ScopeRead))
+	assert.False(t, p.Allows(projectID, token.ScopeRead))
+
+	tok.ProjectID = projectID
+	p = FromUserToken(tok, "alice", []*organization.Member{
+		{OrganizationID: org, Role: organization.RoleOwner},
+	})
+	assert.True(t, p.AllowsIn(org, projectID, token.ScopeWrite))
+	assert.False(t, p.AllowsIn(org, uuid.New(), token.ScopeRead))
+	assert.False(t, p.AllowsIn(org, uuid.Nil, token.ScopeRead))
+}
+
+func TestAnonymous(t *testing.T) {
+	p := Anonymous()
+	assert.True(t, p.Anonymous)
+	assert.False(t, p.Allows(uuid.New(), token.ScopeRead))
+	assert.False(t, p.Allows(uuid.Nil, token.ScopeRead))
+	assert.False(t, p.OrganizationScoped())
+}
@@ -0,0 +1,39 @@
+package project
+
+import (
+	"strings"
+
+	"github.com/benchkram/bobc/pkg/organization"
+)
+
+// Separator separates the organization from the
+// project name in a project path.
+const Separator = "/"
+
+// Path returns the path of a project as referenced by bob,
+// e.g. `benchkram/bobc-example`.
+func Path(organization, name string) string {
+	return organization + Separator + name
+}
+
+// SplitPath splits a project path into organization and project name.
+// A name without organization refers to a project of the default organization.
+func SplitPath(path string) (org, name string) {
+	org, name, found := strings.Cut(path, Separator)
+	if !found {
+		return organization.DefaultName, path
+	}
+	return org, name
+}
+
+// PathKey normalizes a project path for lookups. Paths
+// only differing in case refer to the same project.
+func PathKey(path string) string {
+	return strings.ToLower(Path(SplitPath(path)))
+}
+
+// Path returns the path of the project. Requires
+// the organization to be set.
+func (p *P) Path() string {
+	return Path(p.Organization, p.Name)
+}
@@ -0,0 +1,23 @@
+package project
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitPath(t *testing.T) {
+	org, name := SplitPath("benchkram/bobc-example")
+	assert.Equal(t, "benchkram", org)
+	assert.Equal(t, "bobc-example", name)
+
+	org, name = SplitPath("bobc-example")
+	assert.Equal(t, "default", org)
+	assert.Equal(t, "bobc-example", name)
+}
+
+func TestPathKey(t *testing.T) {
+	assert.Equal(t, "benchkram/bobc-example", PathKey("Benchkram/BOBC-Example"))
+	assert.Equal(t, "default/bobc-example", PathKey("bobc-example"))
+	assert.Equal(t, PathKey("default/bobc-example"), PathKey("bobc-example"))
+}
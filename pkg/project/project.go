@@ -15,14 +15,25 @@ type P struct {
 	Name        string
 	Description string
 	Artifacts   []*artifact.A
+
+	// OrganizationID of the organization owning the project
+	OrganizationID uuid.UUID
+
+	// Organization is the name of the owning organization,
+	// only set when read from the database
+	Organization string
+
+	// Public projects can be read without credentials
+	Public bool
 }
 
-func New(name, description string) *P {
+func New(organizationID uuid.UUID, name, description string) *P {
 	project := &P{
-		ID:          uuid.New(),
-		CreatedAt:   time.Now(),
-		Name:        name,
-		Description: description,
+		ID:             uuid.New(),
+		CreatedAt:      time.Now(),
+		Name:           name,
+		Description:    description,
+		OrganizationID: organizationID,
 	}
 
 	return project
@@ -41,13 +52,26 @@ func FromDBModel(m *model.Project) (*P, error) {
 		artifacts = append(artifacts, artifact)
 	}
 
-	return &P{
+	p := &P{
 		ID:          id,
 		CreatedAt:   m.CreatedAt,
 		Name:        m.Name,
 		Description: m.Description,
 		Artifacts:   artifacts,
-	}, nil
+		Public:      m.Public,
+	}
+
+	if m.OrganizationID != "" {
+		p.OrganizationID, err = uuid.Parse(m.OrganizationID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if m.Organization != nil {
+		p.Organization = m.Organization.Name
+	}
+
+	return p, nil
 }
 
 func (p *P) ToExtendedProjectRestType() generated.ExtendedProject {
@@ -57,18 +81,24 @@ func (p *P) ToExtendedProjectRestType() generated.ExtendedProject {
 	}
 
 	return generated.ExtendedProject{
-		Id:          p.ID.String(),
-		Name:        p.Name,
-		Description: p.Description,
-		Hashes:      &hashlist,
+		Id:           p.ID.String(),
+		Name:         p.Name,
+		Description:  p.Description,
+		Organization: p.Organization,
+		Path:         p.Path(),
+		Public:       p.Public,
+		Hashes:       &hashlist,
 	}
 }
 
 func (p *P) ToProjectRestType() generated.Project {
 	return generated.Project{
-		Id:          p.ID.String(),
-		Name:        p.Name,
-		Description: p.Description,
+		Id:           p.ID.String(),
+		Name:         p.Name,
+		Description:  p.Description,
+		Organization: p.Organization,
+		Path:         p.Path(),
+		Public:       p.Public,
 	}
 }
 
@@ -80,10 +110,13 @@ func (p *P) ToProjectDatabaseType() *model.Project {
 	}
 
 	return &model.Project{
-		ID:          p.ID.String(),
-		CreatedAt:   p.CreatedAt,
-		Name:        p.Name,
-		Description: p.Description,
-		Artifacts:   artifacts,
+		ID:             p.ID.String(),
+		CreatedAt:      p.CreatedAt,
+		Name:           p.Name,
+		Path:           PathKey(p.Path()),
+		Description:    p.Description,
+		Artifacts:      artifacts,
+		OrganizationID: p.OrganizationID.String(),
+		Public:         p.Public,
 	}
 }
@@ -1,21 +1,29 @@
 package projectrepo
 
 import (
+	"context"
 	"errors"
+	"io"
+	"time"
 
 	"github.com/benchkram/bobc/pkg/artifact"
+	"github.com/benchkram/bobc/pkg/checksum"
+	"github.com/benchkram/bobc/pkg/db"
 	"github.com/benchkram/bobc/pkg/db/model"
 	"github.com/benchkram/bobc/pkg/project"
 	"github.com/benchkram/errz"
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
-func (r *Repository) CreateOrUpdate(project *project.P) (err error) {
+// CreateOrUpdate stores a project. Returns ErrAlreadyExists
+// if another project has the same path.
+func (r *Repository) CreateOrUpdate(ctx context.Context, project *project.P) (err error) {
 	defer errz.Recover(&err)
 
 	var projectExists bool
 
-	_, err = r.Project(project.ID)
+	_, err = r.Project(ctx, project.ID)
 	if err != nil {
 		if errors.Is(err, ErrNotFound) {
 			projectExists = false
@@ -28,23 +36,24 @@ func (r *Repository) CreateOrUpdate(project *project.P) (err error) {
 
 	// update project
 	if projectExists {
-		err = r.db.Gorm().Save(project.ToProjectDatabaseType()).Error
-		errz.Fatal(err)
-		return nil
+		err = r.db.Gorm().WithContext(ctx).Save(project.ToProjectDatabaseType()).Error
+	} else {
+		err = r.db.Gorm().WithContext(ctx).Create(project.ToProjectDatabaseType()).Error
+	}
+	// paths are unique ignoring case
+	if db.IsUniqueViolation(err) {
+		return ErrAlreadyExists
 	}
-
-	// create new project
-	err = r.db.Gorm().Create(project.ToProjectDatabaseType()).Error
 	errz.Fatal(err)
 
 	return nil
 }
 
-func (r *Repository) Project(projectID uuid.UUID) (_ *project.P, err error) {
+func (r *Repository) Project(ctx context.Context, projectID uuid.UUID) (_ *project.P, err error) {
 	defer errz.Recover(&err)
 
 	projectGorm := model.Project{}
-	result := r.db.Gorm().Where(&model.Project{
+	result := r.db.Gorm().WithContext(ctx).Preload("Organization").Where(&model.Project{
 		ID: projectID.String(),
 	}).Find(&projectGorm)
 	errz.Fatal(result.Error)
@@ -57,7 +66,7 @@ func (r *Repository) Project(projectID uuid.UUID) (_ *project.P, err error) {
 	// r.db.Gorm().Model(&projectGorm).Association("Artifact").Find(&projectGorm.Hashes)
 
 	// does work, but creates warning unsupported relations for schema Artifact. Needs to be figured out
-	r.db.Gorm().Where("project_id=?", projectGorm.ID).Find(&projectGorm.Artifacts)
+	r.db.Gorm().WithContext(ctx).Where("project_id=?", projectGorm.ID).Find(&projectGorm.Artifacts)
 
 	// does works fine. But Not safe to use raw query
 	// r.db.Gorm().Raw("SELECT * from project_hashes WHERE project_id=?", projectGorm.ID).Find(&projectGorm.Hashes)
@@ -65,16 +74,16 @@ func (r *Repository) Project(projectID uuid.UUID) (_ *project.P, err error) {
 	return project.FromDBModel(&projectGorm)
 }
 
-func (r *Repository) ProjectsByName(name string) ([]*project.P, error) {
+func (r *Repository) ProjectsByName(ctx context.Context, name string) ([]*project.P, error) {
 	var projects []*project.P
 
 	ps := []model.Project{}
 
-	err := r.db.Gorm().Where("name LIKE ?", "%"+name+"%").Find(&ps).Error
+	err := r.db.Gorm().WithContext(ctx).Preload("Organization").Where("name LIKE ?", "%"+name+"%").Find(&ps).Error
 	errz.Fatal(err)
 	for _, p := range ps {
 		// does work, but creates warning unsupported relations for schema Artifact. Needs to be figured out
-		err := r.db.Gorm().Where("project_id=?", p.ID).Find(&p.Artifacts).Error
+		err := r.db.Gorm().WithContext(ctx).Where("project_id=?", p.ID).Find(&p.Artifacts).Error
 		errz.Fatal(err)
 
 		pOut, err := project.FromDBModel(&p)
@@ -86,11 +95,14 @@ func (r *Repository) ProjectsByName(name string) ([]*project.P, error) {
 	return projects, nil
 }
 
-func (r *Repository) ProjectByName(projectName string) (*project.P, error) {
+// ProjectByName looks up a project by its path `organization/project`,
+// ignoring case. A name without organization refers to the default organization.
+func (r *Repository) ProjectByName(ctx context.Context, projectName string) (*project.P, error) {
 	var projectGorm model.Project
 
-	result := r.db.Gorm().
-		Where(&model.Project{Name: projectName}).
+	result := r.db.Gorm().WithContext(ctx).
+		Preload("Organization").
+		Where(&model.Project{Path: project.PathKey(projectName)}).
 		Find(&projectGorm)
 	errz.Fatal(result.Error)
 
@@ -99,24 +111,99 @@ func (r *Repository) ProjectByName(projectName string) (*project.P, error) {
 	}
 
 	// does work, but creates warning unsupported relations for schema Artifact. Needs to be figured out
-	err := r.db.Gorm().Where("project_id=?", projectGorm.ID).Find(&projectGorm.Artifacts).Error
+	err := r.db.Gorm().WithContext(ctx).Where("project_id=?", projectGorm.ID).Find(&projectGorm.Artifacts).Error
 	errz.Fatal(err)
 
 	return project.FromDBModel(&projectGorm)
 }
 
-func (r *Repository) Projects() (projects []*project.P, err error) {
+// ProjectIDByName resolves the id of a project like ProjectByName
+// without loading its artifacts.
+func (r *Repository) ProjectIDByName(ctx context.Context, projectName string) (_ uuid.UUID, err error) {
+	defer errz.Recover(&err)
+
+	var projectGorm model.Project
+
+	result := r.db.Gorm().WithContext(ctx).
+		Select("id").
+		Where(&model.Project{Path: project.PathKey(projectName)}).
+		Find(&projectGorm)
+	errz.Fatal(result.Error)
+
+	if result.RowsAffected == 0 {
+		return uuid.Nil, ErrNotFound
+	}
+
+	return uuid.Parse(projectGorm.ID)
+}
+
+// ProjectOrganizationID resolves the id of the organization owning a project.
+func (r *Repository) ProjectOrganizationID(ctx context.Context, projectID uuid.UUID) (_ uuid.UUID, err error) {
+	defer errz.Recover(&err)
+
+	var projectGorm model.Project
+
+	result := r.db.Gorm().WithContext(ctx).
+		Select("organization_id").
+		Where(&model.Project{ID: projectID.String()}).
+		Find(&projectGorm)
+	errz.Fatal(result.Error)
+
+	if result.RowsAffected == 0 {
+		return uuid.Nil, ErrNotFound
+	}
+
+	return uuid.Parse(projectGorm.OrganizationID)
+}
+
+// ProjectPublic reports if a project can be read without credentials.
+func (r *Repository) ProjectPublic(ctx context.Context, projectID uuid.UUID) (_ bool, err error) {
+	defer errz.Recover(&err)
+
+	var projectGorm model.Project
+
+	result := r.db.Gorm().WithContext(ctx).
+		Select("public").
+		Where(&model.Project{ID: projectID.String()}).
+		Find(&projectGorm)
+	errz.Fatal(result.Error)
+
+	if result.RowsAffected == 0 {
+		return false, ErrNotFound
+	}
+
+	return projectGorm.Public, nil
+}
+
+// ProjectVisibilitySet makes a project public or private.
+func (r *Repository) ProjectVisibilitySet(ctx context.Context, projectID uuid.UUID, public bool) (err error) {
+	defer errz.Recover(&err)
+
+	result := r.db.Gorm().WithContext(ctx).
+		Model(&model.Project{}).
+		Where("id = ?", projectID.String()).
+		Update("public", public)
+	errz.Fatal(result.Error)
+
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) Projects(ctx context.Context) (projects []*project.P, err error) {
 	defer errz.Recover(&err)
 
 	projects = []*project.P{}
 
 	ps := []model.Project{}
 
-	err = r.db.Gorm().Find(&ps).Error
+	err = r.db.Gorm().WithContext(ctx).Preload("Organization").Find(&ps).Error
 	errz.Fatal(err)
 	for _, p := range ps {
 		// does work, but creates warning unsupported relations for schema Artifact. Needs to be figured out
-		err := r.db.Gorm().Where("project_id=?", p.ID).Find(&p.Artifacts).Error
+		err := r.db.Gorm().WithContext(ctx).Where("project_id=?", p.ID).Find(&p.Artifacts).Error
 		errz.Fatal(err)
 
 		pOut, err := project.FromDBModel(&p)
@@ -127,24 +214,87 @@ func (r *Repository) Projects() (projects []*project.P, err error) {
 	return projects, nil
 }
 
-func (r *Repository) ProjectDelete(projectID uuid.UUID) error {
-	result := r.db.Gorm().Delete(&model.Project{
-		ID: projectID.String(),
+func (r *Repository) ProjectDelete(ctx context.Context, projectID uuid.UUID) error {
+	uploads := []*model.Upload{}
+	err := r.db.Gorm().WithContext(ctx).Where("project_id = ?", projectID.String()).Find(&uploads).Error
+	errz.Fatal(err)
+
+	for _, m := range uploads {
+		err = r.abortUpload(ctx, m)
+		errz.Fatal(err)
+	}
+
+	artifacts := []string{}
+	err = r.db.Gorm().WithContext(ctx).Model(&model.Artifact{}).Where("project_id = ?", projectID.String()).Pluck("id", &artifacts).Error
+	errz.Fatal(err)
+
+	// artifacts are deleted explicitly as not every
+	// database (sqlite) got the cascading foreign key.
+	err = r.db.Gorm().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("project_id = ?", projectID.String()).Delete(&model.Artifact{}).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Where("project_id = ?", projectID.String()).Delete(&model.RetentionPolicy{}).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Where("project_id = ?", projectID.String()).Delete(&model.Quota{}).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Where("project_id = ?", projectID.String()).Delete(&model.ReplicationMark{}).Error
+		if err != nil {
+			return err
+		}
+
+		// tokens restricted to the project become useless
+		err = tx.Where("project_id = ?", projectID.String()).Delete(&model.Token{}).Error
+		if err != nil {
+			return err
+		}
+
+		result := tx.Delete(&model.Project{
+			ID: projectID.String(),
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return ErrNotFound
+		}
+		return nil
 	})
-	errz.Fatal(result.Error)
+	if err != nil {
+		return err
+	}
 
-	if result.RowsAffected == 0 {
-		return ErrNotFound
+	// objects left behind on failure are removed by the garbage collector
+	for _, id := range artifacts {
+		err = r.artifactStore.DeleteArtifact(ctx, id)
+		if err != nil {
+			errz.Log(err)
+		}
 	}
+
 	return nil
 }
 
-func (r *Repository) CreateArtifact(projectID uuid.UUID, artifactID string, filePath string, size int) (err error) {
+// CreateArtifact streams src to the artifact store and records the artifact
+// afterwards, so that no artifact is visible before its payload is stored.
+// Size and checksum are computed while streaming. If digest is not empty
+// the artifact is only recorded when the checksum matches. The quota of the
+// project is checked again when recording the artifact.
+func (r *Repository) CreateArtifact(ctx context.Context, projectID uuid.UUID, scope, artifactID, digest string, src io.Reader) (_ *artifact.A, err error) {
 	defer errz.Recover(&err)
 
 	var projectExists bool
 
-	p, err := r.Project(projectID)
+	p, err := r.Project(ctx, projectID)
 	if err != nil {
 		if errors.Is(err, ErrNotFound) {
 			projectExists = false
@@ -156,67 +306,98 @@ func (r *Repository) CreateArtifact(projectID uuid.UUID, artifactID string, file
 	}
 
 	if !projectExists {
-		return ErrNotFound
+		return nil, ErrNotFound
+	}
+
+	id := uuid.New()
+
+	cr := checksum.NewReader(src)
+	err = r.artifactStore.CreateArtifact(ctx, id.String(), cr)
+	errz.Fatal(err)
+
+	if digest != "" && digest != cr.Sum() {
+		_ = r.artifactStore.DeleteArtifact(ctx, id.String())
+		return nil, ErrDigestMismatch
 	}
 
 	h := model.Artifact{
-		ID:         uuid.New().String(),
+		ID:         id.String(),
 		ArtifactID: artifactID,
 		ProjectID:  p.ID.String(),
-		Size:       size,
+		Scope:      scope,
+		Size:       int(cr.Size()),
+		Digest:     cr.Sum(),
+
+		LastAccessedAt: time.Now(),
 	}
 
-	err = r.db.Gorm().Create(&h).Error
-	errz.Fatal(err)
+	err = r.db.Gorm().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := checkQuota(tx, h.ProjectID, int64(h.Size))
+		if err != nil {
+			return err
+		}
 
-	err = r.artifactStore.CreateArtifact(h.ID, filePath, size)
-	errz.Fatal(err)
+		return tx.Create(&h).Error
+	})
+	if err != nil {
+		_ = r.artifactStore.DeleteArtifact(ctx, h.ID)
+		if errors.Is(err, ErrQuotaExceeded) {
+			return nil, ErrQuotaExceeded
+		}
+		errz.Fatal(err)
+	}
 
-	return nil
+	return artifact.FromDatabaseType(&h), nil
 }
 
-func (r *Repository) artifact(projectID uuid.UUID, artifactID string) (_ *model.Artifact, err error) {
+// artifact looks up an artifact in the first of scopes containing it.
+func (r *Repository) artifact(ctx context.Context, projectID uuid.UUID, scopes []string, artifactID string) (_ *model.Artifact, err error) {
 	defer errz.Recover(&err)
 
-	hashGorm := &model.Artifact{}
-	result := r.db.Gorm().Where(&model.Artifact{
-		ProjectID:  projectID.String(),
-		ArtifactID: artifactID,
-	}).Find(hashGorm)
-	errz.Fatal(result.Error)
+	candidates := []*model.Artifact{}
+	err = r.db.Gorm().WithContext(ctx).
+		Where("project_id = ? AND artifact_id = ? AND scope IN ?", projectID.String(), artifactID, scopes).
+		Find(&candidates).Error
+	errz.Fatal(err)
 
-	if result.RowsAffected == 0 {
-		return nil, ErrNotFound
+	for _, scope := range scopes {
+		for _, c := range candidates {
+			if c.Scope == scope {
+				return c, nil
+			}
+		}
 	}
 
-	return hashGorm, nil
+	return nil, ErrNotFound
 }
 
-func (r *Repository) ArtifactUpdate(projectID uuid.UUID, artifactID string) (err error) {
+func (r *Repository) ArtifactUpdate(ctx context.Context, projectID uuid.UUID, scope, artifactID string) (err error) {
 	defer errz.Recover(&err)
 
-	hashGorm, err := r.artifact(projectID, artifactID)
+	hashGorm, err := r.artifact(ctx, projectID, []string{scope}, artifactID)
 	errz.Fatal(err)
 
 	// hashGorm.StoragePath = hash.StoragePath
 
-	err = r.db.Gorm().Save(hashGorm).Error
+	err = r.db.Gorm().WithContext(ctx).Save(hashGorm).Error
 	errz.Fatal(err)
 
 	return nil
 }
 
-func (r *Repository) ProjectArtifact(projectID uuid.UUID, artifactID string) (_ *artifact.A, err error) {
+// ProjectArtifact returns the artifact found in the first of scopes
+// containing it, along with a link to download its payload.
+func (r *Repository) ProjectArtifact(ctx context.Context, projectID uuid.UUID, scopes []string, artifactID string) (_ *artifact.A, err error) {
 	defer errz.Recover(&err)
 
-	artiGorm, err := r.artifact(projectID, artifactID)
+	artiGorm, err := r.artifact(ctx, projectID, scopes, artifactID)
 	if err != nil {
 		return nil, err
 	}
 
 	arti := artifact.FromDatabaseType(artiGorm)
 
-	addr, err := r.artifactStore.Artifact(artiGorm.ID)
+	addr, err := r.artifactStore.Artifact(ctx, artiGorm.ID)
 	errz.Fatal(err)
 
 	arti.AccessLink = addr
@@ -224,33 +405,80 @@ func (r *Repository) ProjectArtifact(projectID uuid.UUID, artifactID string) (_
 	return arti, nil
 }
 
-func (r *Repository) ProjectArtifactDelete(projectID uuid.UUID, artifactID string) (err error) {
+// ArtifactRead opens the payload of an artifact of a single scope.
+// The caller must close it.
+func (r *Repository) ArtifactRead(ctx context.Context, projectID uuid.UUID, scope, artifactID string) (_ io.ReadCloser, err error) {
 	defer errz.Recover(&err)
 
-	hashGorm, err := r.artifact(projectID, artifactID)
+	m, err := r.artifact(ctx, projectID, []string{scope}, artifactID)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.artifactStore.ReadArtifact(ctx, m.ID)
+}
+
+// ProjectArtifactDelete deletes an artifact of a single scope.
+func (r *Repository) ProjectArtifactDelete(ctx context.Context, projectID uuid.UUID, scope, artifactID string) (err error) {
+	defer errz.Recover(&err)
+
+	hashGorm, err := r.artifact(ctx, projectID, []string{scope}, artifactID)
 	errz.Fatal(err)
 
-	err = r.db.Gorm().Delete(hashGorm).Error
+	err = r.db.Gorm().WithContext(ctx).Delete(hashGorm).Error
 	errz.Fatal(err)
 
-	err = r.artifactStore.DeleteArtifact(hashGorm.ID)
+	err = r.artifactStore.DeleteArtifact(ctx, hashGorm.ID)
 	errz.Fatal(err)
 
 	return nil
 }
 
-func (r *Repository) ProjectArtifactExists(projectID uuid.UUID, artifactID string) (_ bool, err error) {
+// ProjectArtifactExists reports if an artifact exists in any of scopes
+// and the first scope containing it.
+func (r *Repository) ProjectArtifactExists(ctx context.Context, projectID uuid.UUID, scopes []string, artifactID string) (_ bool, scope string, err error) {
 	defer errz.Recover(&err)
 
-	_, err = r.artifact(projectID, artifactID)
+	a, err := r.artifact(ctx, projectID, scopes, artifactID)
 	if err != nil {
 		if errors.Is(err, ErrNotFound) {
-			return false, nil
+			return false, "", nil
 		} else {
 			errz.Fatal(err)
 		}
 	}
 
-	return true, nil
+	return true, a.Scope, nil
+}
+
+// ProjectArtifactsExist returns those of artifactIDs which exist in any of
+// scopes using a single query, mapped to the first scope containing them.
+func (r *Repository) ProjectArtifactsExist(ctx context.Context, projectID uuid.UUID, scopes []string, artifactIDs []string) (_ map[string]string, err error) {
+	defer errz.Recover(&err)
+
+	present := map[string]string{}
+	if len(artifactIDs) == 0 {
+		return present, nil
+	}
+
+	found := []*model.Artifact{}
+	err = r.db.Gorm().WithContext(ctx).
+		Select("artifact_id", "scope").
+		Where("project_id = ? AND artifact_id IN ? AND scope IN ?", projectID.String(), artifactIDs, scopes).
+		Find(&found).Error
+	errz.Fatal(err)
+
+	rank := map[string]int{}
+	for i, scope := range scopes {
+		rank[scope] = i
+	}
+
+	for _, a := range found {
+		scope, ok := present[a.ArtifactID]
+		if !ok || rank[a.Scope] < rank[scope] {
+			present[a.ArtifactID] = a.Scope
+		}
+	}
 
+	return present, nil
 }
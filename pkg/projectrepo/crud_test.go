@@ -0,0 +1,45 @@
+package projectrepo
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/benchkram/bobc/pkg/db"
+	"github.com/benchkram/bobc/pkg/localstore"
+	"github.com/benchkram/bobc/pkg/project"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateOrUpdateDuplicatePath(t *testing.T) {
+	ctx := context.Background()
+
+	dir, err := ioutil.TempDir("", "bobc-project-*")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	database := db.New(db.WithSQLite(filepath.Join(dir, "bobc.db")))
+	err = database.Connect()
+	assert.Nil(t, err)
+
+	r := New(database, localstore.New(filepath.Join(dir, "artifacts")))
+
+	orgID := uuid.New()
+	p := project.New(orgID, "Project", "")
+	p.Organization = "acme"
+	err = r.CreateOrUpdate(ctx, p)
+	assert.Nil(t, err)
+
+	// paths are unique ignoring case
+	duplicate := project.New(orgID, "project", "")
+	duplicate.Organization = "ACME"
+	err = r.CreateOrUpdate(ctx, duplicate)
+	assert.ErrorIs(t, err, ErrAlreadyExists)
+
+	p.Description = "updated"
+	err = r.CreateOrUpdate(ctx, p)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,147 @@
+package projectrepo
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/benchkram/bobc/pkg/artifact"
+	"github.com/benchkram/bobc/pkg/db/model"
+	"github.com/benchkram/bobc/pkg/quota"
+	"github.com/benchkram/bobc/pkg/upload"
+	"github.com/benchkram/errz"
+	"github.com/google/uuid"
+)
+
+// maxPresignExpiry is the longest time span a presigned link can be valid on s3.
+const maxPresignExpiry = 7 * 24 * time.Hour
+
+// DirectUploader is implemented by artifact stores which allow clients
+// to send the payload of an artifact directly, bypassing the server.
+type DirectUploader interface {
+	PresignedPut(ctx context.Context, id string, expiry time.Duration) (addr *url.URL, err error)
+	PresignedPutPart(ctx context.Context, id, uploadID string, number int, expiry time.Duration) (addr *url.URL, err error)
+	ListParts(ctx context.Context, id, uploadID string) (etags map[int]string, size int64, err error)
+	Stat(ctx context.Context, id string) (size int64, exists bool, err error)
+}
+
+// DirectUploadCreate starts an upload of an artifact which is sent directly
+// to the artifact store. A multipart upload is used for more than one part.
+// The presigned links are valid till the upload expires. digest is the
+// expected checksum of the payload and can be empty.
+func (r *Repository) DirectUploadCreate(ctx context.Context, projectID uuid.UUID, scope, artifactID, digest string, parts int, expiresAt time.Time) (_ *upload.U, err error) {
+	defer errz.Recover(&err)
+
+	store, ok := r.artifactStore.(DirectUploader)
+	if !ok {
+		return nil, ErrDirectUploadUnsupported
+	}
+
+	_, err = r.Project(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	expiry := time.Until(expiresAt)
+	if expiry > maxPresignExpiry {
+		expiry = maxPresignExpiry
+	}
+
+	m := model.Upload{
+		ID:             uuid.New().String(),
+		ProjectID:      projectID.String(),
+		ArtifactID:     artifactID,
+		Scope:          scope,
+		StorageID:      uuid.New().String(),
+		Direct:         true,
+		ExpectedDigest: digest,
+		ExpiresAt:      expiresAt,
+	}
+
+	links := []*url.URL{}
+	if parts <= 1 {
+		link, err := store.PresignedPut(ctx, m.StorageID, expiry)
+		errz.Fatal(err)
+		links = append(links, link)
+	} else {
+		m.StorageUploadID, err = r.artifactStore.NewMultipartUpload(ctx, m.StorageID)
+		errz.Fatal(err)
+
+		for number := 1; number <= parts; number++ {
+			link, err := store.PresignedPutPart(ctx, m.StorageID, m.StorageUploadID, number, expiry)
+			if err != nil {
+				_ = r.artifactStore.AbortMultipartUpload(ctx, m.StorageID, m.StorageUploadID)
+				errz.Fatal(err)
+			}
+			links = append(links, link)
+		}
+	}
+
+	err = r.db.Gorm().WithContext(ctx).Create(&m).Error
+	if err != nil {
+		if m.StorageUploadID != "" {
+			_ = r.artifactStore.AbortMultipartUpload(ctx, m.StorageID, m.StorageUploadID)
+		}
+		errz.Fatal(err)
+	}
+
+	u := upload.FromDatabaseType(&m)
+	u.Links = links
+
+	return u, nil
+}
+
+// directUploadComplete verifies the payload of a direct upload
+// has arrived in the artifact store and records the artifact
+// with the size reported by the store. Multipart uploads exceeding
+// maxSize are rejected before they are assembled, their parts are
+// kept. A single part upload exceeding maxSize is discarded.
+func (r *Repository) directUploadComplete(ctx context.Context, m *model.Upload, maxSize int64) (_ *artifact.A, err error) {
+	defer errz.Recover(&err)
+
+	store, ok := r.artifactStore.(DirectUploader)
+	if !ok {
+		return nil, ErrDirectUploadUnsupported
+	}
+
+	if m.StorageUploadID != "" {
+		parts, size, err := store.ListParts(ctx, m.StorageID, m.StorageUploadID)
+		errz.Fatal(err)
+
+		if len(parts) == 0 {
+			return nil, ErrUploadIncomplete
+		}
+
+		etags := make([]string, len(parts))
+		for number := 1; number <= len(parts); number++ {
+			etag, ok := parts[number]
+			if !ok {
+				return nil, ErrUploadIncomplete
+			}
+			etags[number-1] = etag
+		}
+
+		if maxSize != quota.Unlimited && size > maxSize {
+			return nil, ErrQuotaExceeded
+		}
+
+		err = r.artifactStore.CompleteMultipartUpload(ctx, m.StorageID, m.StorageUploadID, etags)
+		errz.Fatal(err)
+	}
+
+	size, exists, err := store.Stat(ctx, m.StorageID)
+	errz.Fatal(err)
+
+	if !exists {
+		return nil, ErrUploadIncomplete
+	}
+
+	if maxSize != quota.Unlimited && size > maxSize {
+		err = r.discardUpload(ctx, m)
+		errz.Fatal(err)
+
+		return nil, ErrQuotaExceeded
+	}
+
+	return r.commitUpload(ctx, m, size)
+}
@@ -0,0 +1,96 @@
+package projectrepo
+
+import (
+	"context"
+	"time"
+
+	"github.com/benchkram/bobc/pkg/db/model"
+	"github.com/benchkram/bobc/pkg/gc"
+	"github.com/benchkram/errz"
+)
+
+// gcBatchSize limits the number of rows deleted with a single query.
+const gcBatchSize = 500
+
+// Reconcile compares the artifacts recorded in the database with the objects
+// in the artifact store and finds objects without an artifact and artifacts
+// without an object. Objects modified after modifiedBefore are ignored, as are
+// objects of uploads in progress, as their artifact might not be recorded yet.
+// With apply set the inconsistencies found are removed.
+func (r *Repository) Reconcile(ctx context.Context, modifiedBefore time.Time, apply bool) (_ *gc.Report, err error) {
+	defer errz.Recover(&err)
+
+	// artifacts must be read before listing the store,
+	// as their objects are stored before they are recorded.
+	artifacts := []*model.Artifact{}
+	err = r.db.Gorm().WithContext(ctx).Select("id", "project_id", "artifact_id").Find(&artifacts).Error
+	errz.Fatal(err)
+
+	uploads := []string{}
+	err = r.db.Gorm().WithContext(ctx).Model(&model.Upload{}).Pluck("storage_id", &uploads).Error
+	errz.Fatal(err)
+
+	recorded := map[string]bool{}
+	for _, a := range artifacts {
+		recorded[a.ID] = false
+	}
+	inProgress := map[string]bool{}
+	for _, id := range uploads {
+		inProgress[id] = true
+	}
+
+	report := &gc.Report{
+		Applied:        apply,
+		OrphanObjects:  []string{},
+		MissingObjects: []gc.Artifact{},
+	}
+
+	err = r.artifactStore.ListArtifacts(ctx, func(id string, modified time.Time) error {
+		if _, ok := recorded[id]; ok {
+			recorded[id] = true
+			return nil
+		}
+		if inProgress[id] || modified.After(modifiedBefore) {
+			return nil
+		}
+
+		report.OrphanObjects = append(report.OrphanObjects, id)
+		return nil
+	})
+	errz.Fatal(err)
+
+	missing := []string{}
+	for _, a := range artifacts {
+		if !recorded[a.ID] {
+			missing = append(missing, a.ID)
+			report.MissingObjects = append(report.MissingObjects, gc.Artifact{
+				ID:         a.ID,
+				ProjectID:  a.ProjectID,
+				ArtifactID: a.ArtifactID,
+			})
+		}
+	}
+
+	if !apply {
+		return report, nil
+	}
+
+	for _, id := range report.OrphanObjects {
+		err = r.artifactStore.DeleteArtifact(ctx, id)
+		errz.Fatal(err)
+	}
+
+	for len(missing) > 0 {
+		n := gcBatchSize
+		if len(missing) < n {
+			n = len(missing)
+		}
+
+		err = r.db.Gorm().WithContext(ctx).Where("id IN ?", missing[:n]).Delete(&model.Artifact{}).Error
+		errz.Fatal(err)
+
+		missing = missing[n:]
+	}
+
+	return report, nil
+}
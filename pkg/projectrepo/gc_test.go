@@ -0,0 +1,144 @@
+package projectrepo
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/benchkram/bobc/pkg/db"
+	"github.com/benchkram/bobc/pkg/db/model"
+	"github.com/benchkram/bobc/pkg/localstore"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReconcile(t *testing.T) {
+	ctx := context.Background()
+
+	dir, err := ioutil.TempDir("", "bobc-gc-*")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	database := db.New(db.WithSQLite(filepath.Join(dir, "bobc.db")))
+	err = database.Connect()
+	assert.Nil(t, err)
+
+	store := localstore.New(filepath.Join(dir, "artifacts"))
+	r := New(database, store)
+
+	p := model.Project{ID: uuid.New().String(), Name: "gc"}
+	err = database.Gorm().Create(&p).Error
+	assert.Nil(t, err)
+
+	storeObject := func(id string) {
+		err := store.CreateArtifact(ctx, id, bytes.NewReader(make([]byte, 10)))
+		assert.Nil(t, err)
+	}
+
+	// recorded artifact with its object
+	recorded := model.Artifact{ID: uuid.New().String(), ProjectID: p.ID, ArtifactID: "recorded", Size: 10}
+	err = database.Gorm().Create(&recorded).Error
+	assert.Nil(t, err)
+	storeObject(recorded.ID)
+
+	// object without an artifact
+	orphan := uuid.New().String()
+	storeObject(orphan)
+
+	// object of an upload in progress, its artifact is not recorded yet
+	upload := model.Upload{
+		ID:         uuid.New().String(),
+		ProjectID:  p.ID,
+		ArtifactID: "in-progress",
+		StorageID:  uuid.New().String(),
+		ExpiresAt:  time.Now().Add(time.Hour),
+	}
+	err = database.Gorm().Create(&upload).Error
+	assert.Nil(t, err)
+	storeObject(upload.StorageID)
+
+	// artifacts without an object, more than fit into a single delete
+	missing := []*model.Artifact{}
+	for i := 0; i < gcBatchSize+1; i++ {
+		missing = append(missing, &model.Artifact{
+			ID:         uuid.New().String(),
+			ProjectID:  p.ID,
+			ArtifactID: uuid.New().String(),
+			Size:       10,
+		})
+	}
+	err = database.Gorm().CreateInBatches(missing, 100).Error
+	assert.Nil(t, err)
+
+	missingIDs := []string{}
+	for _, a := range missing {
+		missingIDs = append(missingIDs, a.ID)
+	}
+	sort.Strings(missingIDs)
+
+	countArtifacts := func() int64 {
+		var count int64
+		err := database.Gorm().Model(&model.Artifact{}).Count(&count).Error
+		assert.Nil(t, err)
+		return count
+	}
+	objects := func() []string {
+		ids := []string{}
+		err := store.ListArtifacts(ctx, func(id string, modified time.Time) error {
+			ids = append(ids, id)
+			return nil
+		})
+		assert.Nil(t, err)
+		sort.Strings(ids)
+		return ids
+	}
+	sorted := func(ids ...string) []string {
+		sort.Strings(ids)
+		return ids
+	}
+
+	// objects within the grace period are not orphans yet
+	report, err := r.Reconcile(ctx, time.Now().Add(-time.Hour), false)
+	assert.Nil(t, err)
+	assert.False(t, report.Applied)
+	assert.Empty(t, report.OrphanObjects)
+	assert.Len(t, report.MissingObjects, len(missing))
+
+	// dry run reports without removing anything
+	report, err = r.Reconcile(ctx, time.Now().Add(time.Hour), false)
+	assert.Nil(t, err)
+	assert.False(t, report.Applied)
+	assert.Equal(t, []string{orphan}, report.OrphanObjects)
+
+	reported := []string{}
+	for _, a := range report.MissingObjects {
+		assert.Equal(t, p.ID, a.ProjectID)
+		reported = append(reported, a.ID)
+	}
+	sort.Strings(reported)
+	assert.Equal(t, missingIDs, reported)
+
+	assert.Equal(t, int64(len(missing)+1), countArtifacts())
+	assert.Equal(t, sorted(recorded.ID, orphan, upload.StorageID), objects())
+
+	// apply removes orphan objects and missing artifacts
+	report, err = r.Reconcile(ctx, time.Now().Add(time.Hour), true)
+	assert.Nil(t, err)
+	assert.True(t, report.Applied)
+	assert.Equal(t, []string{orphan}, report.OrphanObjects)
+	assert.Len(t, report.MissingObjects, len(missing))
+
+	assert.Equal(t, int64(1), countArtifacts())
+	assert.Equal(t, sorted(recorded.ID, upload.StorageID), objects())
+
+	// nothing left to do
+	report, err = r.Reconcile(ctx, time.Now().Add(time.Hour), true)
+	assert.Nil(t, err)
+	assert.Empty(t, report.OrphanObjects)
+	assert.Empty(t, report.MissingObjects)
+}
@@ -0,0 +1,14 @@
+package projectrepo
+
+import (
+	"github.com/benchkram/bobc/pkg/health"
+)
+
+// Checks returns the readiness checks of the database and the artifact store.
+func (r *Repository) Checks() []health.Check {
+	return []health.Check{
+		{Name: "database", Fn: r.db.Ping},
+		{Name: "migrations", Fn: r.db.Migrated},
+		{Name: "artifact_store", Fn: r.artifactStore.Ping},
+	}
+}
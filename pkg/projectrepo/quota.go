@@ -0,0 +1,127 @@
+package projectrepo
+
+import (
+	"context"
+
+	"github.com/benchkram/bobc/pkg/db/model"
+	"github.com/benchkram/bobc/pkg/quota"
+	"github.com/benchkram/errz"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Quota returns the storage quota of a project.
+// Projects without a quota get one with all limits disabled.
+func (r *Repository) Quota(ctx context.Context, projectID uuid.UUID) (_ *quota.Q, err error) {
+	defer errz.Recover(&err)
+
+	m := &model.Quota{}
+	result := r.db.Gorm().WithContext(ctx).Where(&model.Quota{
+		ProjectID: projectID.String(),
+	}).Find(m)
+	errz.Fatal(result.Error)
+
+	if result.RowsAffected == 0 {
+		return &quota.Q{ProjectID: projectID}, nil
+	}
+
+	return quota.FromDatabaseType(m), nil
+}
+
+func (r *Repository) QuotaSet(ctx context.Context, q *quota.Q) (err error) {
+	defer errz.Recover(&err)
+
+	err = r.db.Gorm().WithContext(ctx).Save(q.ToDatabaseType()).Error
+	errz.Fatal(err)
+
+	return nil
+}
+
+// ProjectUsage sums up the artifacts of a project without loading them.
+func (r *Repository) ProjectUsage(ctx context.Context, projectID uuid.UUID) (_ quota.Usage, err error) {
+	defer errz.Recover(&err)
+
+	return usage(r.db.Gorm().WithContext(ctx), projectID.String())
+}
+
+// usage sums up the artifacts of a project inside of tx.
+func usage(tx *gorm.DB, projectID string) (quota.Usage, error) {
+	var result struct {
+		Bytes     int64
+		Artifacts int
+	}
+	err := tx.
+		Model(&model.Artifact{}).
+		Select("COALESCE(SUM(size), 0) AS bytes, COUNT(*) AS artifacts").
+		Where("project_id = ?", projectID).
+		Scan(&result).Error
+	if err != nil {
+		return quota.Usage{}, err
+	}
+
+	return quota.Usage{
+		Bytes:     result.Bytes,
+		Artifacts: result.Artifacts,
+	}, nil
+}
+
+// checkQuota checks inside of tx that the quota of a project allows to record
+// another artifact of size bytes. The project row is locked until tx ends,
+// so that artifacts recorded in parallel are checked one after another.
+func checkQuota(tx *gorm.DB, projectID string, size int64) error {
+	err := tx.Model(&model.Project{}).
+		Where("id = ?", projectID).
+		UpdateColumn("updated_at", gorm.Expr("updated_at")).Error
+	if err != nil {
+		return err
+	}
+
+	m := &model.Quota{}
+	result := tx.Where(&model.Quota{ProjectID: projectID}).Find(m)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 || (m.MaxBytes == 0 && m.MaxArtifacts == 0) {
+		return nil
+	}
+
+	u, err := usage(tx, projectID)
+	if err != nil {
+		return err
+	}
+
+	if !quota.FromDatabaseType(m).Allows(u, size, 1) {
+		return ErrQuotaExceeded
+	}
+
+	return nil
+}
+
+// ProjectsUsage sums up the artifacts of all projects, keyed by project path.
+// Projects without artifacts are included with zero usage.
+func (r *Repository) ProjectsUsage(ctx context.Context) (_ map[string]quota.Usage, err error) {
+	defer errz.Recover(&err)
+
+	var rows []struct {
+		Path      string
+		Bytes     int64
+		Artifacts int
+	}
+	err = r.db.Gorm().WithContext(ctx).
+		Model(&model.Project{}).
+		Select("projects.path AS path, COALESCE(SUM(artifacts.size), 0) AS bytes, COUNT(artifacts.id) AS artifacts").
+		Joins("LEFT JOIN artifacts ON artifacts.project_id = projects.id").
+		Group("projects.path").
+		Scan(&rows).Error
+	errz.Fatal(err)
+
+	usage := make(map[string]quota.Usage, len(rows))
+	for _, row := range rows {
+		usage[row.Path] = quota.Usage{
+			Bytes:     row.Bytes,
+			Artifacts: row.Artifacts,
+		}
+	}
+
+	return usage, nil
+}
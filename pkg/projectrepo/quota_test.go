@@ -0,0 +1,60 @@
+package projectrepo
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/benchkram/bobc/pkg/db"
+	"github.com/benchkram/bobc/pkg/db/model"
+	"github.com/benchkram/bobc/pkg/localstore"
+	"github.com/benchkram/bobc/pkg/quota"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateArtifactQuota(t *testing.T) {
+	ctx := context.Background()
+
+	dir, err := ioutil.TempDir("", "bobc-quota-*")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	database := db.New(db.WithSQLite(filepath.Join(dir, "bobc.db")))
+	err = database.Connect()
+	assert.Nil(t, err)
+
+	store := localstore.New(filepath.Join(dir, "artifacts"))
+	r := New(database, store)
+
+	p := model.Project{ID: uuid.New().String(), Name: "quota"}
+	err = database.Gorm().Create(&p).Error
+	assert.Nil(t, err)
+	projectID := uuid.MustParse(p.ID)
+
+	err = r.QuotaSet(ctx, &quota.Q{ProjectID: projectID, MaxBytes: 15})
+	assert.Nil(t, err)
+
+	_, err = r.CreateArtifact(ctx, projectID, "", "a", "", bytes.NewReader(make([]byte, 10)))
+	assert.Nil(t, err)
+
+	// the artifact is stored, but not recorded
+	_, err = r.CreateArtifact(ctx, projectID, "", "b", "", bytes.NewReader(make([]byte, 10)))
+	assert.ErrorIs(t, err, ErrQuotaExceeded)
+
+	u, err := r.ProjectUsage(ctx, projectID)
+	assert.Nil(t, err)
+	assert.Equal(t, quota.Usage{Bytes: 10, Artifacts: 1}, u)
+
+	objects := 0
+	err = store.ListArtifacts(ctx, func(id string, modified time.Time) error {
+		objects++
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, objects)
+}
@@ -0,0 +1,63 @@
+package projectrepo
+
+import (
+	"context"
+	"time"
+
+	"github.com/benchkram/bobc/pkg/artifact"
+	"github.com/benchkram/bobc/pkg/db/model"
+	"github.com/benchkram/errz"
+	"github.com/google/uuid"
+)
+
+// ReplicationMark returns the creation time of the newest artifact of a
+// project replicated to target. The zero time if it was never replicated.
+func (r *Repository) ReplicationMark(ctx context.Context, target string, projectID uuid.UUID) (_ time.Time, err error) {
+	defer errz.Recover(&err)
+
+	m := &model.ReplicationMark{}
+	result := r.db.Gorm().WithContext(ctx).Where(&model.ReplicationMark{
+		Target:    target,
+		ProjectID: projectID.String(),
+	}).Find(m)
+	errz.Fatal(result.Error)
+
+	if result.RowsAffected == 0 {
+		return time.Time{}, nil
+	}
+
+	return m.Mark, nil
+}
+
+func (r *Repository) ReplicationMarkSet(ctx context.Context, target string, projectID uuid.UUID, mark time.Time) (err error) {
+	defer errz.Recover(&err)
+
+	err = r.db.Gorm().WithContext(ctx).Save(&model.ReplicationMark{
+		Target:    target,
+		ProjectID: projectID.String(),
+		Mark:      mark,
+	}).Error
+	errz.Fatal(err)
+
+	return nil
+}
+
+// ArtifactsSince returns the artifacts of a project created
+// at or after t, of all scopes, ordered by creation time.
+func (r *Repository) ArtifactsSince(ctx context.Context, projectID uuid.UUID, t time.Time) (_ []*artifact.A, err error) {
+	defer errz.Recover(&err)
+
+	ms := []*model.Artifact{}
+	err = r.db.Gorm().WithContext(ctx).
+		Where("project_id = ? AND created_at >= ?", projectID.String(), t).
+		Order("created_at, id").
+		Find(&ms).Error
+	errz.Fatal(err)
+
+	artifacts := make([]*artifact.A, 0, len(ms))
+	for _, m := range ms {
+		artifacts = append(artifacts, artifact.FromDatabaseType(m))
+	}
+
+	return artifacts, nil
+}
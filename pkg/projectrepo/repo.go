@@ -1,20 +1,40 @@
 package projectrepo
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"net/url"
+	"time"
 
 	"github.com/benchkram/bobc/pkg/db"
 )
 
 var (
-	ErrNotFound = fmt.Errorf("not found")
+	ErrNotFound         = fmt.Errorf("not found")
+	ErrAlreadyExists    = fmt.Errorf("already exists")
+	ErrUploadIncomplete = fmt.Errorf("upload incomplete")
+	ErrUploadDirect     = fmt.Errorf("upload is sent directly to the artifact store")
+	ErrDigestMismatch   = fmt.Errorf("digest mismatch")
+	ErrQuotaExceeded    = fmt.Errorf("quota exceeded")
+
+	ErrDirectUploadUnsupported = fmt.Errorf("direct uploads not supported by artifact store")
 )
 
 type ArtifactStore interface {
-	CreateArtifact(id, filePath string, size int) (err error)
-	DeleteArtifact(id string) (err error)
-	Artifact(id string) (addr *url.URL, err error)
+	CreateArtifact(ctx context.Context, id string, src io.Reader) (err error)
+	DeleteArtifact(ctx context.Context, id string) (err error)
+	Artifact(ctx context.Context, id string) (addr *url.URL, err error)
+	ReadArtifact(ctx context.Context, id string) (_ io.ReadCloser, err error)
+	ListArtifacts(ctx context.Context, fn func(id string, modified time.Time) error) (err error)
+
+	NewMultipartUpload(ctx context.Context, id string) (uploadID string, err error)
+	PutPart(ctx context.Context, id, uploadID string, number int, src io.Reader, size int64) (etag string, err error)
+	CompleteMultipartUpload(ctx context.Context, id, uploadID string, etags []string) (err error)
+	AbortMultipartUpload(ctx context.Context, id, uploadID string) (err error)
+
+	// Ping checks that the store is accessible.
+	Ping(ctx context.Context) (err error)
 }
 
 type Repository struct {
@@ -0,0 +1,81 @@
+package projectrepo
+
+import (
+	"context"
+	"time"
+
+	"github.com/benchkram/bobc/pkg/db/model"
+	"github.com/benchkram/bobc/pkg/retention"
+	"github.com/benchkram/errz"
+	"github.com/google/uuid"
+)
+
+// RetentionPolicy returns the retention policy of a project.
+// Projects without a policy get one with all limits disabled.
+func (r *Repository) RetentionPolicy(ctx context.Context, projectID uuid.UUID) (_ *retention.Policy, err error) {
+	defer errz.Recover(&err)
+
+	m := &model.RetentionPolicy{}
+	result := r.db.Gorm().WithContext(ctx).Where(&model.RetentionPolicy{
+		ProjectID: projectID.String(),
+	}).Find(m)
+	errz.Fatal(result.Error)
+
+	if result.RowsAffected == 0 {
+		return &retention.Policy{ProjectID: projectID}, nil
+	}
+
+	return retention.FromDatabaseType(m), nil
+}
+
+func (r *Repository) RetentionPolicySet(ctx context.Context, p *retention.Policy) (err error) {
+	defer errz.Recover(&err)
+
+	err = r.db.Gorm().WithContext(ctx).Save(p.ToDatabaseType()).Error
+	errz.Fatal(err)
+
+	return nil
+}
+
+// RetentionPolicies returns all policies with at least one limit enabled.
+func (r *Repository) RetentionPolicies(ctx context.Context) (_ []*retention.Policy, err error) {
+	defer errz.Recover(&err)
+
+	ms := []*model.RetentionPolicy{}
+	err = r.db.Gorm().WithContext(ctx).Where("max_age > 0 OR max_bytes > 0 OR keep_last > 0").Find(&ms).Error
+	errz.Fatal(err)
+
+	policies := []*retention.Policy{}
+	for _, m := range ms {
+		policies = append(policies, retention.FromDatabaseType(m))
+	}
+
+	return policies, nil
+}
+
+// ArtifactTouch records an access to an artifact. To limit writes the
+// timestamp is only updated when the previous access is older than a minute.
+func (r *Repository) ArtifactTouch(ctx context.Context, projectID uuid.UUID, scope, artifactID string, t time.Time) (err error) {
+	defer errz.Recover(&err)
+
+	err = r.db.Gorm().WithContext(ctx).Model(&model.Artifact{}).
+		Where("project_id = ? AND scope = ? AND artifact_id = ? AND (last_accessed_at IS NULL OR last_accessed_at < ?)",
+			projectID.String(), scope, artifactID, t.Add(-time.Minute)).
+		UpdateColumn("last_accessed_at", t).Error
+	errz.Fatal(err)
+
+	return nil
+}
+
+// ArtifactAccessedSet sets the last access of an artifact to t, e.g. to
+// restore it on import. Unlike ArtifactTouch older times are kept as well.
+func (r *Repository) ArtifactAccessedSet(ctx context.Context, projectID uuid.UUID, scope, artifactID string, t time.Time) (err error) {
+	defer errz.Recover(&err)
+
+	err = r.db.Gorm().WithContext(ctx).Model(&model.Artifact{}).
+		Where("project_id = ? AND scope = ? AND artifact_id = ?", projectID.String(), scope, artifactID).
+		UpdateColumn("last_accessed_at", t).Error
+	errz.Fatal(err)
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package projectrepo
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/benchkram/bobc/pkg/db"
+	"github.com/benchkram/bobc/pkg/db/model"
+	"github.com/benchkram/bobc/pkg/localstore"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArtifactAccessed(t *testing.T) {
+	ctx := context.Background()
+
+	dir, err := ioutil.TempDir("", "bobc-retention-*")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	database := db.New(db.WithSQLite(filepath.Join(dir, "bobc.db")))
+	err = database.Connect()
+	assert.Nil(t, err)
+
+	r := New(database, localstore.New(filepath.Join(dir, "artifacts")))
+
+	p := model.Project{ID: uuid.New().String(), Name: "retention"}
+	err = database.Gorm().Create(&p).Error
+	assert.Nil(t, err)
+	projectID := uuid.MustParse(p.ID)
+
+	_, err = r.CreateArtifact(ctx, projectID, "feature", "abc", "", bytes.NewReader(make([]byte, 10)))
+	assert.Nil(t, err)
+
+	lastAccessed := func() time.Time {
+		a, err := r.ProjectArtifact(ctx, projectID, []string{"feature"}, "abc")
+		assert.Nil(t, err)
+		return a.LastAccessedAt
+	}
+	created := lastAccessed()
+
+	// touching never moves the last access back
+	past := time.Now().Add(-48 * time.Hour)
+	err = r.ArtifactTouch(ctx, projectID, "feature", "abc", past)
+	assert.Nil(t, err)
+	assert.WithinDuration(t, created, lastAccessed(), time.Millisecond)
+
+	err = r.ArtifactAccessedSet(ctx, projectID, "feature", "abc", past)
+	assert.Nil(t, err)
+	assert.WithinDuration(t, past, lastAccessed(), time.Millisecond)
+}
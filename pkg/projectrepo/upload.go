@@ -0,0 +1,305 @@
+package projectrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"time"
+
+	"github.com/benchkram/bobc/pkg/artifact"
+	"github.com/benchkram/bobc/pkg/checksum"
+	"github.com/benchkram/bobc/pkg/db/model"
+	"github.com/benchkram/bobc/pkg/quota"
+	"github.com/benchkram/bobc/pkg/upload"
+	"github.com/benchkram/errz"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// UploadCreate starts a multipart upload of an artifact in the artifact store.
+// digest is the expected checksum of the payload and can be empty.
+func (r *Repository) UploadCreate(ctx context.Context, projectID uuid.UUID, scope, artifactID, digest string, expiresAt time.Time) (_ *upload.U, err error) {
+	defer errz.Recover(&err)
+
+	_, err = r.Project(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	storageID := uuid.New().String()
+	storageUploadID, err := r.artifactStore.NewMultipartUpload(ctx, storageID)
+	errz.Fatal(err)
+
+	m := model.Upload{
+		ID:              uuid.New().String(),
+		ProjectID:       projectID.String(),
+		ArtifactID:      artifactID,
+		Scope:           scope,
+		StorageID:       storageID,
+		StorageUploadID: storageUploadID,
+		ExpectedDigest:  digest,
+		ExpiresAt:       expiresAt,
+	}
+
+	err = r.db.Gorm().WithContext(ctx).Create(&m).Error
+	if err != nil {
+		_ = r.artifactStore.AbortMultipartUpload(ctx, storageID, storageUploadID)
+		errz.Fatal(err)
+	}
+
+	return upload.FromDatabaseType(&m), nil
+}
+
+func (r *Repository) upload(ctx context.Context, projectID, uploadID uuid.UUID) (_ *model.Upload, err error) {
+	defer errz.Recover(&err)
+
+	m := &model.Upload{}
+	result := r.db.Gorm().WithContext(ctx).Preload("Parts").Where(&model.Upload{
+		ID:        uploadID.String(),
+		ProjectID: projectID.String(),
+	}).Find(m)
+	errz.Fatal(result.Error)
+
+	if result.RowsAffected == 0 {
+		return nil, ErrNotFound
+	}
+
+	return m, nil
+}
+
+func (r *Repository) Upload(ctx context.Context, projectID, uploadID uuid.UUID) (_ *upload.U, err error) {
+	defer errz.Recover(&err)
+
+	m, err := r.upload(ctx, projectID, uploadID)
+	if err != nil {
+		return nil, err
+	}
+
+	return upload.FromDatabaseType(m), nil
+}
+
+// UploadPart stores a part of an upload, replacing a previously uploaded part with the same number.
+func (r *Repository) UploadPart(ctx context.Context, projectID, uploadID uuid.UUID, number int, src io.Reader, size int64) (err error) {
+	defer errz.Recover(&err)
+
+	m, err := r.upload(ctx, projectID, uploadID)
+	if err != nil {
+		return err
+	}
+
+	if m.Direct {
+		return ErrUploadDirect
+	}
+
+	etag, err := r.artifactStore.PutPart(ctx, m.StorageID, m.StorageUploadID, number, src, size)
+	errz.Fatal(err)
+
+	err = r.db.Gorm().WithContext(ctx).Save(&model.UploadPart{
+		UploadID: m.ID,
+		Number:   number,
+		ETag:     etag,
+		Size:     size,
+	}).Error
+	errz.Fatal(err)
+
+	return nil
+}
+
+// UploadComplete assembles the artifact from the uploaded parts
+// and records it. The upload is removed afterwards. Artifacts larger
+// than maxSize are rejected, pass quota.Unlimited to disable the check.
+// The parts of a rejected upload are kept so it can be completed later.
+func (r *Repository) UploadComplete(ctx context.Context, projectID, uploadID uuid.UUID, maxSize int64) (_ *artifact.A, err error) {
+	defer errz.Recover(&err)
+
+	m, err := r.upload(ctx, projectID, uploadID)
+	if err != nil {
+		return nil, err
+	}
+
+	if m.Direct {
+		return r.directUploadComplete(ctx, m, maxSize)
+	}
+
+	if !upload.FromDatabaseType(m).Complete() {
+		return nil, ErrUploadIncomplete
+	}
+
+	etags := make([]string, len(m.Parts))
+	var size int64
+	for _, p := range m.Parts {
+		etags[p.Number-1] = p.ETag
+		size += p.Size
+	}
+
+	if maxSize != quota.Unlimited && size > maxSize {
+		return nil, ErrQuotaExceeded
+	}
+
+	err = r.artifactStore.CompleteMultipartUpload(ctx, m.StorageID, m.StorageUploadID, etags)
+	errz.Fatal(err)
+
+	return r.commitUpload(ctx, m, size)
+}
+
+// commitUpload records the artifact of an upload which has been assembled
+// in the artifact store and removes the upload. The payload of a resumable
+// upload is read back from the store to compute its digest, which must match
+// the expected one. Direct uploads are not read back, so the payload never
+// passes through the server. Their digest can't be verified and isn't recorded.
+// The quota of the project is checked again when recording the artifact, the
+// upload is discarded if it is exceeded.
+func (r *Repository) commitUpload(ctx context.Context, m *model.Upload, size int64) (_ *artifact.A, err error) {
+	defer errz.Recover(&err)
+
+	var digest string
+	if !m.Direct {
+		digest, err = r.digest(ctx, m.StorageID, size)
+		errz.Fatal(err)
+	}
+
+	if !m.Direct && m.ExpectedDigest != "" && m.ExpectedDigest != digest {
+		err = r.discardUpload(ctx, m)
+		errz.Fatal(err)
+
+		return nil, ErrDigestMismatch
+	}
+
+	h := model.Artifact{
+		ID:         m.StorageID,
+		ArtifactID: m.ArtifactID,
+		ProjectID:  m.ProjectID,
+		Scope:      m.Scope,
+		Size:       int(size),
+		Digest:     digest,
+
+		LastAccessedAt: time.Now(),
+	}
+
+	err = r.db.Gorm().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := checkQuota(tx, h.ProjectID, size)
+		if err != nil {
+			return err
+		}
+
+		err = tx.Create(&h).Error
+		if err != nil {
+			return err
+		}
+
+		return deleteUpload(tx, m.ID)
+	})
+	if errors.Is(err, ErrQuotaExceeded) {
+		err = r.discardUpload(ctx, m)
+		errz.Fatal(err)
+
+		return nil, ErrQuotaExceeded
+	} else if err != nil {
+		_ = r.artifactStore.DeleteArtifact(ctx, h.ID)
+		errz.Fatal(err)
+	}
+
+	return artifact.FromDatabaseType(&h), nil
+}
+
+// discardUpload removes an upload whose payload has been assembled
+// in the artifact store already, so it can't be continued.
+func (r *Repository) discardUpload(ctx context.Context, m *model.Upload) (err error) {
+	defer errz.Recover(&err)
+
+	err = r.artifactStore.DeleteArtifact(ctx, m.StorageID)
+	errz.Fatal(err)
+
+	return r.db.Gorm().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return deleteUpload(tx, m.ID)
+	})
+}
+
+// digest computes the checksum of the payload of artifact id
+// by reading it from the artifact store.
+func (r *Repository) digest(ctx context.Context, id string, size int64) (_ string, err error) {
+	defer errz.Recover(&err)
+
+	src, err := r.artifactStore.ReadArtifact(ctx, id)
+	errz.Fatal(err)
+	defer src.Close()
+
+	cr := checksum.NewReader(src)
+	_, err = io.Copy(ioutil.Discard, cr)
+	errz.Fatal(err)
+
+	if cr.Size() != size {
+		return "", fmt.Errorf("size of artifact %s is %d, expected %d", id, cr.Size(), size)
+	}
+
+	return cr.Sum(), nil
+}
+
+// UploadAbort discards an upload and all its parts.
+func (r *Repository) UploadAbort(ctx context.Context, projectID, uploadID uuid.UUID) (err error) {
+	defer errz.Recover(&err)
+
+	m, err := r.upload(ctx, projectID, uploadID)
+	if err != nil {
+		return err
+	}
+
+	return r.abortUpload(ctx, m)
+}
+
+// UploadsExpire aborts all uploads which expired before t.
+// Returns the number of aborted uploads.
+func (r *Repository) UploadsExpire(ctx context.Context, t time.Time) (_ int, err error) {
+	defer errz.Recover(&err)
+
+	uploads := []*model.Upload{}
+	err = r.db.Gorm().WithContext(ctx).Where("expires_at < ?", t).Find(&uploads).Error
+	errz.Fatal(err)
+
+	for _, m := range uploads {
+		err = r.abortUpload(ctx, m)
+		errz.Fatal(err)
+	}
+
+	return len(uploads), nil
+}
+
+func (r *Repository) abortUpload(ctx context.Context, m *model.Upload) (err error) {
+	defer errz.Recover(&err)
+
+	if m.StorageUploadID != "" {
+		err = r.artifactStore.AbortMultipartUpload(ctx, m.StorageID, m.StorageUploadID)
+	} else {
+		// direct upload in a single request, the payload might have arrived already
+		err = r.artifactStore.DeleteArtifact(ctx, m.StorageID)
+	}
+	if err != nil {
+		// the upload might be gone from the store already,
+		// make sure it's not tried again and again.
+		errz.Log(err)
+	}
+
+	return r.db.Gorm().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return deleteUpload(tx, m.ID)
+	})
+}
+
+// deleteUpload removes an upload and its parts from the database.
+func deleteUpload(tx *gorm.DB, uploadID string) error {
+	err := tx.Where("upload_id = ?", uploadID).Delete(&model.UploadPart{}).Error
+	if err != nil {
+		return err
+	}
+
+	result := tx.Delete(&model.Upload{ID: uploadID})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
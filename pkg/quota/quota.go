@@ -0,0 +1,86 @@
+package quota
+
+import (
+	"github.com/benchkram/bobc/pkg/db/model"
+	"github.com/benchkram/bobc/restserver/generated"
+	"github.com/google/uuid"
+)
+
+// Unlimited is returned by Remaining when no byte limit is set.
+const Unlimited = -1
+
+// Q limits the storage a project can use.
+// A zero value disables the respective limit.
+type Q struct {
+	ProjectID uuid.UUID
+
+	// MaxBytes is the maximum total size of all artifacts of a project
+	MaxBytes int64
+
+	// MaxArtifacts is the maximum number of artifacts of a project
+	MaxArtifacts int
+}
+
+// Usage is the storage currently used by a project.
+type Usage struct {
+	Bytes     int64
+	Artifacts int
+}
+
+// Allows reports if the usage stays within the quota
+// when grown by bytes and artifacts.
+func (q *Q) Allows(u Usage, bytes int64, artifacts int) bool {
+	if q.MaxArtifacts > 0 && u.Artifacts+artifacts > q.MaxArtifacts {
+		return false
+	}
+	if q.MaxBytes > 0 && u.Bytes+bytes > q.MaxBytes {
+		return false
+	}
+	return true
+}
+
+// Remaining returns the number of bytes which can still be stored,
+// or Unlimited if there is no byte limit.
+func (q *Q) Remaining(u Usage) int64 {
+	if q.MaxBytes <= 0 {
+		return Unlimited
+	}
+	if u.Bytes >= q.MaxBytes {
+		return 0
+	}
+	return q.MaxBytes - u.Bytes
+}
+
+func FromDatabaseType(m *model.Quota) *Q {
+	return &Q{
+		ProjectID:    uuid.MustParse(m.ProjectID),
+		MaxBytes:     m.MaxBytes,
+		MaxArtifacts: m.MaxArtifacts,
+	}
+}
+
+func (q *Q) ToDatabaseType() *model.Quota {
+	return &model.Quota{
+		ProjectID:    q.ProjectID.String(),
+		MaxBytes:     q.MaxBytes,
+		MaxArtifacts: q.MaxArtifacts,
+	}
+}
+
+func FromRestType(projectID uuid.UUID, r generated.Quota) *Q {
+	return &Q{
+		ProjectID:    projectID,
+		MaxBytes:     r.MaxBytes,
+		MaxArtifacts: r.MaxArtifacts,
+	}
+}
+
+// ToUsageRestType reports the usage u against the quota.
+func (q *Q) ToUsageRestType(u Usage) generated.ProjectUsage {
+	return generated.ProjectUsage{
+		Bytes:        u.Bytes,
+		Artifacts:    u.Artifacts,
+		MaxBytes:     q.MaxBytes,
+		MaxArtifacts: q.MaxArtifacts,
+	}
+}
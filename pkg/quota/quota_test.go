@@ -0,0 +1,49 @@
+package quota
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllows(t *testing.T) {
+	u := Usage{Bytes: 90, Artifacts: 9}
+
+	q := &Q{}
+	assert.True(t, q.Allows(u, 1000, 1000))
+	assert.Equal(t, int64(Unlimited), q.Remaining(u))
+
+	q = &Q{MaxBytes: 100, MaxArtifacts: 10}
+	assert.True(t, q.Allows(u, 10, 1))
+	assert.False(t, q.Allows(u, 11, 1))
+	assert.False(t, q.Allows(u, 0, 2))
+	assert.Equal(t, int64(10), q.Remaining(u))
+
+	// limits lowered below the current usage
+	q = &Q{MaxBytes: 50}
+	assert.Equal(t, int64(0), q.Remaining(u))
+}
+
+func TestReader(t *testing.T) {
+	src := bytes.Repeat([]byte{1}, 100)
+
+	r := NewReader(bytes.NewReader(src), Unlimited)
+	n, err := io.Copy(ioutil.Discard, r)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), n)
+	assert.False(t, r.Exceeded())
+
+	r = NewReader(bytes.NewReader(src), 100)
+	_, err = io.Copy(ioutil.Discard, r)
+	assert.NoError(t, err)
+	assert.False(t, r.Exceeded())
+
+	r = NewReader(bytes.NewReader(src), 99)
+	_, err = io.Copy(ioutil.Discard, r)
+	assert.True(t, errors.Is(err, ErrExceeded))
+	assert.True(t, r.Exceeded())
+}
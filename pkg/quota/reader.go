@@ -0,0 +1,47 @@
+package quota
+
+import (
+	"errors"
+	"io"
+)
+
+var ErrExceeded = errors.New("quota exceeded")
+
+// Reader passes reads through to an underlying reader and fails
+// with ErrExceeded as soon as more than limit bytes are read.
+type Reader struct {
+	r        io.Reader
+	limit    int64
+	n        int64
+	exceeded bool
+}
+
+// NewReader limits r to limit bytes. A limit of Unlimited
+// passes all reads through.
+func NewReader(r io.Reader, limit int64) *Reader {
+	return &Reader{
+		r:     r,
+		limit: limit,
+	}
+}
+
+func (r *Reader) Read(p []byte) (n int, err error) {
+	if r.exceeded {
+		return 0, ErrExceeded
+	}
+
+	n, err = r.r.Read(p)
+	r.n += int64(n)
+	if r.limit != Unlimited && r.n > r.limit {
+		r.exceeded = true
+		return n, ErrExceeded
+	}
+	return n, err
+}
+
+// Exceeded reports if more than limit bytes have been read.
+// Stores might wrap the error returned by Read, so this is
+// the reliable way to detect the cause of a failed write.
+func (r *Reader) Exceeded() bool {
+	return r.exceeded
+}
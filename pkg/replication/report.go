@@ -0,0 +1,69 @@
+package replication
+
+import (
+	"fmt"
+	"io"
+)
+
+// Report is the result of replicating projects to a target.
+type Report struct {
+	Target   string
+	Projects []*Project
+}
+
+// Project is the result of replicating a single project.
+type Project struct {
+	// Path of the project, the same on source and target
+	Path string
+
+	// Checked is the number of artifacts created
+	// since the last run, compared with the target
+	Checked int
+
+	// Transferred is the number of artifacts missing on the target and copied
+	Transferred int
+
+	// Failed is the number of artifacts which couldn't be copied.
+	// They are tried again on the next run.
+	Failed int
+
+	// Err is set when the project couldn't be replicated
+	// at all, e.g. as it's missing on the target
+	Err error
+}
+
+func (r *Report) Transferred() (n int) {
+	for _, p := range r.Projects {
+		n += p.Transferred
+	}
+	return n
+}
+
+// Failed counts the artifacts which couldn't be copied
+// and the projects which couldn't be replicated at all.
+func (r *Report) Failed() (n int) {
+	for _, p := range r.Projects {
+		n += p.Failed
+		if p.Err != nil {
+			n++
+		}
+	}
+	return n
+}
+
+func (r *Report) Summary() string {
+	return fmt.Sprintf("transferred %d artifacts of %d projects to %s, %d failures",
+		r.Transferred(), len(r.Projects), r.Target, r.Failed())
+}
+
+// Print writes the result per project to w.
+func (r *Report) Print(w io.Writer) {
+	for _, p := range r.Projects {
+		if p.Err != nil {
+			fmt.Fprintf(w, "%s: %v\n", p.Path, p.Err)
+			continue
+		}
+		fmt.Fprintf(w, "%s: checked %d, transferred %d, failed %d\n", p.Path, p.Checked, p.Transferred, p.Failed)
+	}
+	fmt.Fprintln(w, r.Summary())
+}
@@ -0,0 +1,100 @@
+package retention
+
+import (
+	"sort"
+	"time"
+
+	"github.com/benchkram/bobc/pkg/artifact"
+	"github.com/benchkram/bobc/pkg/db/model"
+	"github.com/benchkram/bobc/restserver/generated"
+	"github.com/google/uuid"
+)
+
+// Policy limits the artifacts kept for a project.
+// A zero value disables the respective limit.
+type Policy struct {
+	ProjectID uuid.UUID
+
+	// MaxAge of an artifact since its creation
+	MaxAge time.Duration
+
+	// MaxBytes is the maximum total size of all artifacts of a project
+	MaxBytes int64
+
+	// KeepLast is the number of most recently used artifacts to keep
+	KeepLast int
+}
+
+// Enabled reports if any limit is set.
+func (p *Policy) Enabled() bool {
+	return p.MaxAge > 0 || p.MaxBytes > 0 || p.KeepLast > 0
+}
+
+// Evict returns the artifacts violating the policy at time now. Artifacts
+// are ranked by their last access, so that the least recently used ones
+// are evicted first when the size or count limit is exceeded.
+func (p *Policy) Evict(artifacts []*artifact.A, now time.Time) []*artifact.A {
+	ranked := make([]*artifact.A, len(artifacts))
+	copy(ranked, artifacts)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].LastAccessedAt.After(ranked[j].LastAccessedAt)
+	})
+
+	evict := []*artifact.A{}
+	var kept int
+	var keptBytes int64
+	for _, a := range ranked {
+		if p.MaxAge > 0 && now.Sub(a.CreatedAt) > p.MaxAge {
+			evict = append(evict, a)
+			continue
+		}
+		if p.KeepLast > 0 && kept >= p.KeepLast {
+			evict = append(evict, a)
+			continue
+		}
+		if p.MaxBytes > 0 && keptBytes+int64(a.Size) > p.MaxBytes {
+			evict = append(evict, a)
+			continue
+		}
+
+		kept++
+		keptBytes += int64(a.Size)
+	}
+
+	return evict
+}
+
+func FromDatabaseType(m *model.RetentionPolicy) *Policy {
+	return &Policy{
+		ProjectID: uuid.MustParse(m.ProjectID),
+		MaxAge:    time.Duration(m.MaxAge) * time.Second,
+		MaxBytes:  m.MaxBytes,
+		KeepLast:  m.KeepLast,
+	}
+}
+
+func (p *Policy) ToDatabaseType() *model.RetentionPolicy {
+	return &model.RetentionPolicy{
+		ProjectID: p.ProjectID.String(),
+		MaxAge:    int64(p.MaxAge / time.Second),
+		MaxBytes:  p.MaxBytes,
+		KeepLast:  p.KeepLast,
+	}
+}
+
+func FromRestType(projectID uuid.UUID, r generated.RetentionPolicy) *Policy {
+	return &Policy{
+		ProjectID: projectID,
+		MaxAge:    time.Duration(r.MaxAgeSeconds) * time.Second,
+		MaxBytes:  r.MaxBytes,
+		KeepLast:  r.KeepLast,
+	}
+}
+
+func (p *Policy) ToRestType() generated.RetentionPolicy {
+	return generated.RetentionPolicy{
+		MaxAgeSeconds: int64(p.MaxAge / time.Second),
+		MaxBytes:      p.MaxBytes,
+		KeepLast:      p.KeepLast,
+	}
+}
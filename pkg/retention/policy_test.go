@@ -0,0 +1,46 @@
+package retention
+
+import (
+	"testing"
+	"time"
+
+	"github.com/benchkram/bobc/pkg/artifact"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvict(t *testing.T) {
+	now := time.Now()
+
+	// ordered from least to most recently used
+	artifacts := []*artifact.A{
+		{ID: "old", Size: 10, CreatedAt: now.Add(-48 * time.Hour), LastAccessedAt: now.Add(-time.Hour)},
+		{ID: "a", Size: 10, CreatedAt: now.Add(-time.Hour), LastAccessedAt: now.Add(-30 * time.Minute)},
+		{ID: "b", Size: 10, CreatedAt: now.Add(-time.Hour), LastAccessedAt: now.Add(-20 * time.Minute)},
+		{ID: "c", Size: 10, CreatedAt: now.Add(-time.Hour), LastAccessedAt: now.Add(-10 * time.Minute)},
+	}
+
+	ids := func(as []*artifact.A) []string {
+		result := []string{}
+		for _, a := range as {
+			result = append(result, a.ID)
+		}
+		return result
+	}
+
+	p := &Policy{}
+	assert.False(t, p.Enabled())
+	assert.Empty(t, p.Evict(artifacts, now))
+
+	p = &Policy{MaxAge: 24 * time.Hour}
+	assert.Equal(t, []string{"old"}, ids(p.Evict(artifacts, now)))
+
+	p = &Policy{KeepLast: 2}
+	assert.Equal(t, []string{"a", "old"}, ids(p.Evict(artifacts, now)))
+
+	p = &Policy{MaxBytes: 25}
+	assert.Equal(t, []string{"a", "old"}, ids(p.Evict(artifacts, now)))
+
+	// artifacts evicted by age don't count towards the other limits
+	p = &Policy{MaxAge: 24 * time.Hour, KeepLast: 3}
+	assert.Equal(t, []string{"old"}, ids(p.Evict(artifacts, now)))
+}
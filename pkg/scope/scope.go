@@ -0,0 +1,60 @@
+// Package scope separates the artifacts of a project into namespaces,
+// e.g. one per branch, so that builds of a feature branch can't poison
+// the cache read by builds of the main branch.
+package scope
+
+import (
+	"context"
+	"regexp"
+)
+
+// Default is the scope of artifacts uploaded without a scope.
+const Default = ""
+
+// maxLength limits the length of a scope, enough for git refs.
+const maxLength = 255
+
+var valid = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._/-]*$`)
+
+// Valid reports if s can be used as scope. Scopes consist of alphanumerics,
+// periods, hyphens, underscores and slashes, e.g. `refs/heads/main`.
+func Valid(s string) bool {
+	if s == Default {
+		return true
+	}
+	return len(s) <= maxLength && valid.MatchString(s)
+}
+
+// Chain returns the scopes artifacts are read from for a request in scope s,
+// in order: s itself, the fallback scopes and finally the default scope.
+func Chain(s string, fallback []string) []string {
+	chain := []string{s}
+	seen := map[string]bool{s: true}
+
+	for _, f := range fallback {
+		if !seen[f] {
+			seen[f] = true
+			chain = append(chain, f)
+		}
+	}
+
+	if !seen[Default] {
+		chain = append(chain, Default)
+	}
+
+	return chain
+}
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx carrying scope s.
+func NewContext(ctx context.Context, s string) context.Context {
+	return context.WithValue(ctx, contextKey{}, s)
+}
+
+// FromContext returns the scope carried by ctx,
+// the default scope if there is none.
+func FromContext(ctx context.Context) string {
+	s, _ := ctx.Value(contextKey{}).(string)
+	return s
+}
@@ -0,0 +1,29 @@
+package scope
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValid(t *testing.T) {
+	assert.True(t, Valid(Default))
+	assert.True(t, Valid("main"))
+	assert.True(t, Valid("refs/heads/feature/x-1.2_b"))
+	assert.False(t, Valid("/main"))
+	assert.False(t, Valid("main branch"))
+	assert.False(t, Valid(string(make([]byte, 256))))
+}
+
+func TestChain(t *testing.T) {
+	assert.Equal(t, []string{"feature", "main", Default}, Chain("feature", []string{"main"}))
+	assert.Equal(t, []string{"main", Default}, Chain("main", []string{"main"}))
+	assert.Equal(t, []string{Default, "main"}, Chain(Default, []string{"main"}))
+	assert.Equal(t, []string{Default}, Chain(Default, nil))
+}
+
+func TestContext(t *testing.T) {
+	assert.Equal(t, Default, FromContext(context.Background()))
+	assert.Equal(t, "main", FromContext(NewContext(context.Background(), "main")))
+}
@@ -0,0 +1,38 @@
+package token
+
+// Scope of an api token. Each scope includes the ones below it.
+type Scope string
+
+const (
+	// ScopeRead allows to download artifacts and read project metadata
+	ScopeRead Scope = "read"
+
+	// ScopeWrite allows to upload and delete artifacts
+	ScopeWrite Scope = "write"
+
+	// ScopeAdmin allows to manage projects and tokens
+	ScopeAdmin Scope = "admin"
+)
+
+func (s Scope) level() int {
+	switch s {
+	case ScopeRead:
+		return 1
+	case ScopeWrite:
+		return 2
+	case ScopeAdmin:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// Valid reports if s is a known scope.
+func (s Scope) Valid() bool {
+	return s.level() > 0
+}
+
+// Includes reports if s grants at least the permissions of other.
+func (s Scope) Includes(other Scope) bool {
+	return s.Valid() && s.level() >= other.level()
+}
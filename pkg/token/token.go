@@ -0,0 +1,130 @@
+package token
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/benchkram/bobc/pkg/db/model"
+	"github.com/benchkram/bobc/restserver/generated"
+	"github.com/google/uuid"
+)
+
+// Prefix of every secret, makes tokens recognizable for secret scanners.
+const Prefix = "bobc_"
+
+// T is an api token.
+type T struct {
+	ID   uuid.UUID
+	Name string
+
+	Scope Scope
+
+	// ProjectID restricts the token to a single project.
+	// uuid.Nil allows access to all projects.
+	ProjectID uuid.UUID
+
+	// UserID of the user the token acts for, uuid.Nil for tokens
+	// not bound to a user. The scope is limited by the user's roles.
+	UserID uuid.UUID
+
+	// ExpiresAt is zero for tokens which never expire
+	ExpiresAt time.Time
+
+	CreatedAt time.Time
+}
+
+// Expired reports if the token has expired at time now.
+func (t *T) Expired(now time.Time) bool {
+	return !t.ExpiresAt.IsZero() && !now.Before(t.ExpiresAt)
+}
+
+// NewSecret generates a random secret.
+func NewSecret() (string, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return Prefix + hex.EncodeToString(b), nil
+}
+
+// Hash returns the hash of secret as stored in the database.
+// Secrets are random and long enough for a plain sha256.
+func Hash(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// IsToken reports if secret looks like an api token.
+func IsToken(secret string) bool {
+	return strings.HasPrefix(secret, Prefix)
+}
+
+func FromDatabaseType(m *model.Token) *T {
+	t := &T{
+		ID:        uuid.MustParse(m.ID),
+		Name:      m.Name,
+		Scope:     Scope(m.Scope),
+		CreatedAt: m.CreatedAt,
+	}
+	if m.ProjectID != nil {
+		t.ProjectID = uuid.MustParse(*m.ProjectID)
+	}
+	if m.UserID != nil {
+		t.UserID = uuid.MustParse(*m.UserID)
+	}
+	if m.ExpiresAt != nil {
+		t.ExpiresAt = *m.ExpiresAt
+	}
+	return t
+}
+
+// ToDatabaseType converts the token to its database representation
+// storing hash as the hash of its secret.
+func (t *T) ToDatabaseType(hash string) *model.Token {
+	m := &model.Token{
+		ID:        t.ID.String(),
+		Name:      t.Name,
+		Hash:      hash,
+		Scope:     string(t.Scope),
+		CreatedAt: t.CreatedAt,
+	}
+	if t.ProjectID != uuid.Nil {
+		projectID := t.ProjectID.String()
+		m.ProjectID = &projectID
+	}
+	if t.UserID != uuid.Nil {
+		userID := t.UserID.String()
+		m.UserID = &userID
+	}
+	if !t.ExpiresAt.IsZero() {
+		expiresAt := t.ExpiresAt
+		m.ExpiresAt = &expiresAt
+	}
+	return m
+}
+
+func (t *T) ToRestType() generated.Token {
+	r := generated.Token{
+		Id:        t.ID.String(),
+		Name:      t.Name,
+		Scope:     generated.TokenScope(t.Scope),
+		CreatedAt: t.CreatedAt,
+	}
+	if t.ProjectID != uuid.Nil {
+		projectID := t.ProjectID.String()
+		r.ProjectId = &projectID
+	}
+	if t.UserID != uuid.Nil {
+		userID := t.UserID.String()
+		r.UserId = &userID
+	}
+	if !t.ExpiresAt.IsZero() {
+		expiresAt := t.ExpiresAt
+		r.ExpiresAt = &expiresAt
+	}
+	return r
+}
@@ -0,0 +1,40 @@
+package token
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScopeIncludes(t *testing.T) {
+	assert.True(t, ScopeWrite.Includes(ScopeRead))
+	assert.True(t, ScopeWrite.Includes(ScopeWrite))
+	assert.False(t, ScopeWrite.Includes(ScopeAdmin))
+	assert.True(t, ScopeAdmin.Includes(ScopeWrite))
+
+	assert.False(t, Scope("unknown").Valid())
+	assert.False(t, Scope("unknown").Includes(ScopeRead))
+}
+
+func TestExpired(t *testing.T) {
+	now := time.Now()
+
+	tok := &T{}
+	assert.False(t, tok.Expired(now))
+
+	tok = &T{ExpiresAt: now.Add(time.Minute)}
+	assert.False(t, tok.Expired(now))
+	assert.True(t, tok.Expired(now.Add(time.Minute)))
+}
+
+func TestSecret(t *testing.T) {
+	secret, err := NewSecret()
+	assert.Nil(t, err)
+	assert.True(t, IsToken(secret))
+
+	other, err := NewSecret()
+	assert.Nil(t, err)
+	assert.NotEqual(t, Hash(secret), Hash(other))
+	assert.Equal(t, Hash(secret), Hash(secret))
+}
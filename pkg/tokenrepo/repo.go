@@ -0,0 +1,82 @@
+package tokenrepo
+
+import (
+	"fmt"
+
+	"github.com/benchkram/bobc/pkg/db"
+	"github.com/benchkram/bobc/pkg/db/model"
+	"github.com/benchkram/bobc/pkg/token"
+	"github.com/benchkram/errz"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrNotFound      = fmt.Errorf("not found")
+	ErrAlreadyExists = fmt.Errorf("already exists")
+)
+
+type Repository struct {
+	db db.Database
+}
+
+func New(db db.Database) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// TokenCreate stores a token along with the hash of its secret.
+func (r *Repository) TokenCreate(t *token.T, hash string) (err error) {
+	defer errz.Recover(&err)
+
+	err = r.db.Gorm().Create(t.ToDatabaseType(hash)).Error
+	if db.IsUniqueViolation(err) {
+		return ErrAlreadyExists
+	}
+	errz.Fatal(err)
+
+	return nil
+}
+
+func (r *Repository) Tokens() (_ []*token.T, err error) {
+	defer errz.Recover(&err)
+
+	ms := []*model.Token{}
+	err = r.db.Gorm().Order("created_at").Find(&ms).Error
+	errz.Fatal(err)
+
+	tokens := []*token.T{}
+	for _, m := range ms {
+		tokens = append(tokens, token.FromDatabaseType(m))
+	}
+
+	return tokens, nil
+}
+
+// TokenByHash looks up a token by the hash of its secret.
+func (r *Repository) TokenByHash(hash string) (_ *token.T, err error) {
+	defer errz.Recover(&err)
+
+	m := &model.Token{}
+	result := r.db.Gorm().Where(&model.Token{Hash: hash}).Find(m)
+	errz.Fatal(result.Error)
+
+	if result.RowsAffected == 0 {
+		return nil, ErrNotFound
+	}
+
+	return token.FromDatabaseType(m), nil
+}
+
+func (r *Repository) TokenDelete(id uuid.UUID) (err error) {
+	defer errz.Recover(&err)
+
+	result := r.db.Gorm().Delete(&model.Token{ID: id.String()})
+	errz.Fatal(result.Error)
+
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestSetup(t *testing.T) {
+	shutdown, err := Setup(ExporterNone, "", false)
+	require.NoError(t, err)
+	assert.NoError(t, shutdown(context.Background()))
+
+	_, err = Setup("jaeger", "", false)
+	assert.ErrorIs(t, err, ErrInvalidExporter)
+}
+
+func TestEnd(t *testing.T) {
+	sr := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr)))
+
+	ctx, parent := Start(context.Background(), "parent")
+
+	_, span := Start(ctx, "failing")
+	err := fmt.Errorf("connection refused")
+	End(span, &err)
+
+	err = nil
+	End(parent, &err)
+
+	spans := sr.Ended()
+	require.Len(t, spans, 2)
+
+	assert.Equal(t, "failing", spans[0].Name())
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+	assert.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent().SpanID())
+
+	assert.Equal(t, "parent", spans[1].Name())
+	assert.Equal(t, codes.Unset, spans[1].Status().Code)
+}
+
+func TestDetach(t *testing.T) {
+	ctx, span := Start(context.Background(), "request")
+	defer span.End()
+
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	detached := Detach(ctx)
+	assert.NoError(t, detached.Err())
+	assert.Equal(t, span.SpanContext(), trace.SpanContextFromContext(detached))
+}
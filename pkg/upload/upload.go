@@ -0,0 +1,109 @@
+package upload
+
+import (
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/benchkram/bobc/pkg/db/model"
+	"github.com/benchkram/bobc/restserver/generated"
+	"github.com/google/uuid"
+)
+
+// U is a resumable upload of an artifact in multiple parts.
+type U struct {
+	ID uuid.UUID
+
+	// ArtifactID of the artifact being uploaded
+	ArtifactID string
+
+	// Scope the artifact is recorded in on completion
+	Scope string
+
+	// ExpiresAt is the time the upload is discarded
+	// if not completed till then
+	ExpiresAt time.Time
+
+	// Parts received so far, ordered by number.
+	// Always empty for direct uploads.
+	Parts []Part
+
+	// Direct is set when the payload is sent directly to the
+	// artifact store, bypassing the server.
+	Direct bool
+
+	// Links to send the parts of a direct upload to, ordered by number.
+	// Only known right after the upload has been created.
+	Links []*url.URL
+}
+
+type Part struct {
+	Number int
+	Size   int64
+}
+
+func FromDatabaseType(m *model.Upload) *U {
+	parts := []Part{}
+	for _, p := range m.Parts {
+		parts = append(parts, Part{
+			Number: p.Number,
+			Size:   p.Size,
+		})
+	}
+	sort.Slice(parts, func(i, j int) bool {
+		return parts[i].Number < parts[j].Number
+	})
+
+	return &U{
+		ID:         uuid.MustParse(m.ID),
+		ArtifactID: m.ArtifactID,
+		Scope:      m.Scope,
+		ExpiresAt:  m.ExpiresAt,
+		Parts:      parts,
+		Direct:     m.Direct,
+	}
+}
+
+// Complete reports if parts are numbered from 1 without gaps.
+func (u *U) Complete() bool {
+	if len(u.Parts) == 0 {
+		return false
+	}
+	for i, p := range u.Parts {
+		if p.Number != i+1 {
+			return false
+		}
+	}
+	return true
+}
+
+func (u *U) ToRestType() generated.Upload {
+	chunks := []generated.UploadChunk{}
+	for _, p := range u.Parts {
+		chunks = append(chunks, generated.UploadChunk{
+			Number: p.Number,
+			Size:   p.Size,
+		})
+	}
+
+	return generated.Upload{
+		Id:         u.ID.String(),
+		ArtifactId: u.ArtifactID,
+		ExpiresAt:  u.ExpiresAt,
+		Chunks:     chunks,
+	}
+}
+
+func (u *U) ToDirectRestType() generated.DirectUpload {
+	urls := []string{}
+	for _, l := range u.Links {
+		urls = append(urls, l.String())
+	}
+
+	return generated.DirectUpload{
+		Id:         u.ID.String(),
+		ArtifactId: u.ArtifactID,
+		ExpiresAt:  u.ExpiresAt,
+		Urls:       urls,
+	}
+}
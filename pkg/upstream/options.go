@@ -0,0 +1,16 @@
+package upstream
+
+import (
+	"net/http"
+	"time"
+)
+
+type Option func(*Upstream)
+
+// WithTimeout limits the time a request to the upstream may take,
+// including the transfer of an artifact. No limit is applied if 0.
+func WithTimeout(timeout time.Duration) Option {
+	return func(u *Upstream) {
+		u.httpClient = &http.Client{Timeout: timeout}
+	}
+}
@@ -0,0 +1,211 @@
+package upstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/benchkram/bobc/pkg/artifact"
+	restserverclient "github.com/benchkram/bobc/rest-server-client"
+	"github.com/benchkram/bobc/restserver/generated"
+	"github.com/benchkram/errz"
+)
+
+var ErrNotFound = fmt.Errorf("not found")
+
+// Upstream fetches artifacts from another bobc server
+// and forwards uploads or replicates artifacts to it.
+type Upstream struct {
+	address string
+	apiKey  []byte
+
+	httpClient *http.Client
+
+	// clients holds a client per scope,
+	// as they send the scope with every request
+	clients map[string]*restserverclient.C
+	mux     sync.Mutex
+}
+
+// New creates an upstream for the bobc server at address,
+// with or without protocol, authenticating with apiKey.
+func New(address string, apiKey []byte, opts ...Option) *Upstream {
+	u := &Upstream{
+		address:    address,
+		apiKey:     apiKey,
+		httpClient: http.DefaultClient,
+		clients:    map[string]*restserverclient.C{},
+	}
+
+	for _, opt := range opts {
+		if opt != nil {
+			opt(u)
+		}
+	}
+
+	return u
+}
+
+// Address identifies the upstream.
+func (u *Upstream) Address() string {
+	return u.address
+}
+
+func (u *Upstream) client(scope string) (_ *restserverclient.C, err error) {
+	defer errz.Recover(&err)
+
+	u.mux.Lock()
+	defer u.mux.Unlock()
+
+	c, ok := u.clients[scope]
+	if ok {
+		return c, nil
+	}
+
+	c, err = restserverclient.New(u.address, u.apiKey,
+		restserverclient.WithScope(scope),
+		restserverclient.WithHTTPClient(u.httpClient),
+	)
+	errz.Fatal(err)
+
+	u.clients[scope] = c
+
+	return c, nil
+}
+
+// Artifact looks up an artifact of the project at projectPath, read from
+// scope and the fallbacks of the upstream, and opens its payload through
+// the download link handed out. The caller must close the payload.
+func (u *Upstream) Artifact(ctx context.Context, projectPath, scope, artifactID string) (_ *artifact.A, _ io.ReadCloser, err error) {
+	defer errz.Recover(&err)
+
+	c, err := u.client(scope)
+	errz.Fatal(err)
+
+	a, err := c.ArtifactContext(ctx, projectPath, artifactID)
+	if errors.Is(err, restserverclient.ErrItemNotFound) {
+		return nil, nil, ErrNotFound
+	}
+	errz.Fatal(err)
+
+	if a.Location == nil {
+		return nil, nil, fmt.Errorf("artifact %s of project %s has no location", artifactID, projectPath)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, *a.Location, nil)
+	errz.Fatal(err)
+
+	resp, err := u.httpClient.Do(req)
+	errz.Fatal(err)
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, nil, fmt.Errorf("download of artifact %s of project %s failed with status %d", artifactID, projectPath, resp.StatusCode)
+	}
+
+	result := &artifact.A{
+		ID:   a.Id,
+		Size: a.Size,
+	}
+	if a.Scope != nil {
+		result.Scope = *a.Scope
+	}
+	if a.Digest != nil {
+		result.Digest = *a.Digest
+	}
+
+	return result, resp.Body, nil
+}
+
+// ArtifactCreate uploads an artifact to scope of the project at projectPath.
+// Artifacts already existing upstream are not considered an error.
+func (u *Upstream) ArtifactCreate(ctx context.Context, projectPath, scope, artifactID, digest string, src io.Reader) (err error) {
+	defer errz.Recover(&err)
+
+	c, err := u.client(scope)
+	errz.Fatal(err)
+
+	err = c.ArtifactCreateFromReaderContext(ctx, projectPath, artifactID, digest, src)
+	if errors.Is(err, restserverclient.ErrItemAlreadyExists) {
+		return nil
+	}
+	errz.Fatal(err)
+
+	return nil
+}
+
+// ArtifactsExist looks up artifacts of the project at projectPath, read
+// from scope and the fallbacks of the upstream. The artifacts present are
+// mapped to the scope they were found in. Returns ErrNotFound if the
+// project is missing.
+func (u *Upstream) ArtifactsExist(ctx context.Context, projectPath, scope string, artifactIDs []string) (_ map[string]string, err error) {
+	defer errz.Recover(&err)
+
+	c, err := u.client(scope)
+	errz.Fatal(err)
+
+	result, err := c.ArtifactsExistContext(ctx, projectPath, artifactIDs)
+	if errors.Is(err, restserverclient.ErrItemNotFound) {
+		return nil, ErrNotFound
+	}
+	errz.Fatal(err)
+
+	scopes := make(map[string]string, len(result.Present))
+	for _, id := range result.Present {
+		// the default scope isn't reported
+		scopes[id] = ""
+		if result.Scopes != nil {
+			if sc, ok := result.Scopes.Get(id); ok {
+				scopes[id] = sc
+			}
+		}
+	}
+
+	return scopes, nil
+}
+
+// OrganizationCreate creates an organization. Organizations
+// already existing upstream are not considered an error.
+func (u *Upstream) OrganizationCreate(ctx context.Context, name, description string) (err error) {
+	defer errz.Recover(&err)
+
+	c, err := u.client("")
+	errz.Fatal(err)
+
+	_, err = c.OrganizationCreate(ctx, name, description)
+	if errors.Is(err, restserverclient.ErrItemAlreadyExists) {
+		return nil
+	}
+	errz.Fatal(err)
+
+	return nil
+}
+
+// ProjectCreate creates the project at projectPath, its organization must
+// exist upstream. Projects already existing upstream are not considered an
+// error, their description and visibility are left as they are.
+func (u *Upstream) ProjectCreate(ctx context.Context, projectPath, description string, public bool) (err error) {
+	defer errz.Recover(&err)
+
+	c, err := u.client("")
+	errz.Fatal(err)
+
+	_, err = c.ProjectCreateContext(ctx, generated.ProjectCreate{
+		Name:        projectPath,
+		Description: description,
+	})
+	if errors.Is(err, restserverclient.ErrItemAlreadyExists) {
+		return nil
+	}
+	errz.Fatal(err)
+
+	if public {
+		err = c.VisibilitySet(ctx, projectPath, true)
+		errz.Fatal(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package upstream
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/benchkram/bobc/pkg/optional"
+	"github.com/benchkram/bobc/restserver/generated"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// server fakes a bobc server holding artifact `abc`
+// of project `acme/app` in scope `main`.
+func server(t *testing.T) *httptest.Server {
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/api/") {
+			assert.Equal(t, "Bearer key", r.Header.Get("Authorization"))
+		}
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.EscapedPath() == "/api/project/acme%2Fapp/artifact/abc":
+			if r.Header.Get("Bob-Scope") != "main" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(generated.Artifact{
+				Id:       "abc",
+				Size:     5,
+				Scope:    optional.String("main"),
+				Location: optional.String(srv.URL + "/download/abc"),
+			})
+		case r.Method == http.MethodPost && r.URL.EscapedPath() == "/api/project/acme%2Fapp/artifacts/exists":
+			var ids []string
+			_ = json.NewDecoder(r.Body).Decode(&ids)
+			result := generated.ArtifactsExist{Present: []string{}, Missing: []string{}}
+			for _, id := range ids {
+				if id == "abc" && r.Header.Get("Bob-Scope") == "main" {
+					result.Present = append(result.Present, id)
+					result.Scopes = &generated.ArtifactsExist_Scopes{}
+					result.Scopes.Set(id, "main")
+				} else {
+					result.Missing = append(result.Missing, id)
+				}
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(result)
+		case r.Method == http.MethodGet && r.URL.Path == "/download/abc":
+			_, _ = io.WriteString(w, "hello")
+		case r.Method == http.MethodPost && r.URL.EscapedPath() == "/api/project/acme%2Fapp/artifacts":
+			assert.Equal(t, "feature", r.Header.Get("Bob-Scope"))
+			w.WriteHeader(http.StatusConflict)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return srv
+}
+
+func TestArtifact(t *testing.T) {
+	srv := server(t)
+	defer srv.Close()
+
+	u := New(srv.URL, []byte("key"))
+
+	a, src, err := u.Artifact(context.Background(), "acme/app", "main", "abc")
+	require.Nil(t, err)
+	defer src.Close()
+
+	assert.Equal(t, "abc", a.ID)
+	assert.Equal(t, "main", a.Scope)
+
+	payload, err := ioutil.ReadAll(src)
+	assert.Nil(t, err)
+	assert.Equal(t, "hello", string(payload))
+
+	_, _, err = u.Artifact(context.Background(), "acme/app", "feature", "abc")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestArtifactCreate(t *testing.T) {
+	srv := server(t)
+	defer srv.Close()
+
+	u := New(srv.URL, []byte("key"))
+
+	// artifacts already existing upstream are fine
+	err := u.ArtifactCreate(context.Background(), "acme/app", "feature", "abc", "", strings.NewReader("hello"))
+	assert.Nil(t, err)
+}
+
+func TestArtifactsExist(t *testing.T) {
+	srv := server(t)
+	defer srv.Close()
+
+	u := New(srv.URL, []byte("key"))
+
+	scopes, err := u.ArtifactsExist(context.Background(), "acme/app", "main", []string{"abc", "def"})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"abc": "main"}, scopes)
+
+	_, err = u.ArtifactsExist(context.Background(), "acme/other", "main", []string{"abc"})
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestTimeout(t *testing.T) {
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/download/slow":
+			<-r.Context().Done()
+		default:
+			_ = json.NewEncoder(w).Encode(generated.Artifact{
+				Id:       "slow",
+				Location: optional.String(srv.URL + "/download/slow"),
+			})
+		}
+	}))
+	defer srv.Close()
+
+	u := New(srv.URL, []byte("key"), WithTimeout(50*time.Millisecond))
+
+	start := time.Now()
+	_, _, err := u.Artifact(context.Background(), "acme/app", "", "slow")
+	assert.NotNil(t, err)
+	assert.Less(t, time.Since(start), 5*time.Second)
+
+	// the request is canceled with the context as well
+	u = New(srv.URL, []byte("key"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, _, err = u.Artifact(ctx, "acme/app", "", "slow")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,50 @@
+package user
+
+import (
+	"time"
+
+	"github.com/benchkram/bobc/pkg/db/model"
+	"github.com/benchkram/bobc/restserver/generated"
+	"github.com/google/uuid"
+)
+
+// U is a user. Users get access to projects through
+// their roles in organizations and act through api tokens.
+type U struct {
+	ID   uuid.UUID
+	Name string
+
+	CreatedAt time.Time
+}
+
+func New(name string) *U {
+	return &U{
+		ID:        uuid.New(),
+		Name:      name,
+		CreatedAt: time.Now(),
+	}
+}
+
+func FromDatabaseType(m *model.User) *U {
+	return &U{
+		ID:        uuid.MustParse(m.ID),
+		Name:      m.Name,
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+func (u *U) ToDatabaseType() *model.User {
+	return &model.User{
+		ID:        u.ID.String(),
+		Name:      u.Name,
+		CreatedAt: u.CreatedAt,
+	}
+}
+
+func (u *U) ToRestType() generated.User {
+	return generated.User{
+		Id:        u.ID.String(),
+		Name:      u.Name,
+		CreatedAt: u.CreatedAt,
+	}
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/benchkram/bobc/pkg/upstream"
+	"github.com/benchkram/errz"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	rootCmd.AddCommand(replicateCmd)
+}
+
+var replicateCmd = &cobra.Command{
+	Use:   "replicate",
+	Short: "replicate projects to another bobc server",
+	Long: `Copies artifacts missing on the server given with --replicate-target.
+Only artifacts created since the last run to the same target are compared.
+Projects missing on the target are created along with their organization.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		replicate()
+	},
+}
+
+func replicate() {
+	if GlobalConfig.ReplicateTarget == "" {
+		errz.Fatal(fmt.Errorf("--replicate-target is required"))
+	}
+
+	app, _, err := newApplication()
+	errz.Fatal(err)
+
+	target := upstream.New(GlobalConfig.ReplicateTarget, []byte(GlobalConfig.ReplicateAPIKey),
+		upstream.WithTimeout(GlobalConfig.ReplicateTimeout),
+	)
+	report, err := app.Replicate(target, GlobalConfig.ReplicateProjects)
+	errz.Fatal(err)
+
+	report.Print(os.Stdout)
+
+	if report.Failed() > 0 {
+		os.Exit(1)
+	}
+}
@@ -3,14 +3,19 @@ package restserverclient
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"mime/multipart"
 	"net/http"
+	"net/url"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/benchkram/bobc/restserver/generated"
 	"github.com/benchkram/errz"
@@ -22,32 +27,91 @@ var (
 	ErrCantReadResponse    = fmt.Errorf("can not read response from server")
 	ErrInvalidJsonResponse = fmt.Errorf("invalid response from the server")
 	ErrItemNotFound        = fmt.Errorf("desired Item not found")
+	ErrItemAlreadyExists   = fmt.Errorf("item already exists")
 )
 
 const (
 	HeaderBobExists = "Bob-Exists"
+	HeaderBobScope  = "Bob-Scope"
 )
 
 type C struct {
 	client *generated.ClientWithResponses
+
+	// scope artifacts are uploaded to and read from, the default scope if empty
+	scope string
+
+	// httpClient sends the requests, http.DefaultClient if nil
+	httpClient *http.Client
+}
+
+type Option func(c *C)
+
+// WithScope makes the client upload and read artifacts in a scope, e.g. a branch.
+func WithScope(scope string) Option {
+	return func(c *C) {
+		c.scope = scope
+	}
 }
 
-// Creates New Client from the address without the protocol
-func New(address string, apiKey []byte) (*C, error) {
-	c, err := generated.NewClientWithResponses(
-		"http://"+address,
+// WithHTTPClient makes the client send its requests through httpClient,
+// e.g. to apply a timeout.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *C) {
+		c.httpClient = httpClient
+	}
+}
+
+// Creates New Client from the address without the protocol,
+// which defaults to http. A url including the protocol is used as is.
+func New(address string, apiKey []byte, opts ...Option) (*C, error) {
+	cl := &C{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(cl)
+		}
+	}
+
+	if !strings.Contains(address, "://") {
+		address = "http://" + address
+	}
+
+	clientOpts := []generated.ClientOption{
 		generated.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
 			req.Header.Set("Authorization", "Bearer "+string(apiKey))
-			return nil
+			if cl.scope != "" {
+				req.Header.Set(HeaderBobScope, cl.scope)
+			}
+			return unescapePath(req)
 		}),
-	)
+	}
+	if cl.httpClient != nil {
+		clientOpts = append(clientOpts, generated.WithHTTPClient(cl.httpClient))
+	}
+
+	c, err := generated.NewClientWithResponses(address, clientOpts...)
 	if err != nil {
 		return nil, err
 	}
 
-	return &C{
-		client: c,
-	}, nil
+	cl.client = c
+
+	return cl, nil
+}
+
+// unescapePath undoes the second escaping the generated client applies to
+// path parameters, which turns the slash of a project path `org/project`
+// into `%252F` instead of `%2F`.
+func unescapePath(req *http.Request) error {
+	path, err := url.PathUnescape(req.URL.Path)
+	if err != nil {
+		return err
+	}
+
+	req.URL.RawPath = req.URL.Path
+	req.URL.Path = path
+
+	return nil
 }
 
 func (c *C) Health() bool {
@@ -109,15 +173,23 @@ func (c *C) Projects() ([]generated.Project, error) {
 }
 
 func (c *C) ProjectCreate(project generated.ProjectCreate) (*generated.ExtendedProject, error) {
+	return c.ProjectCreateContext(context.Background(), project)
+}
 
+// ProjectCreateContext is like ProjectCreate, the request is canceled with ctx.
+func (c *C) ProjectCreateContext(ctx context.Context, project generated.ProjectCreate) (*generated.ExtendedProject, error) {
 	response, err := c.client.CreateProjectWithResponse(
-		context.Background(),
+		ctx,
 		generated.CreateProjectJSONRequestBody(project),
 	)
 	if err != nil {
 		return nil, ErrCantMakeRequest
 	}
 
+	if response.StatusCode() == http.StatusConflict {
+		return nil, ErrItemAlreadyExists
+	}
+
 	if response.StatusCode() != http.StatusOK {
 		return nil, fmt.Errorf("create project failed %w, http status %d, body: %s", ErrInvalidStatusCode, response.StatusCode(), string(response.Body))
 	}
@@ -125,6 +197,49 @@ func (c *C) ProjectCreate(project generated.ProjectCreate) (*generated.ExtendedP
 	return response.JSON200, nil
 }
 
+// VisibilitySet makes a project public or private.
+func (c *C) VisibilitySet(ctx context.Context, projectId string, public bool) error {
+	response, err := c.client.SetVisibilityWithResponse(
+		ctx,
+		projectId,
+		generated.SetVisibilityJSONRequestBody{Public: public},
+	)
+	if err != nil {
+		return ErrCantMakeRequest
+	}
+
+	if response.StatusCode() == http.StatusNotFound {
+		return ErrItemNotFound
+	}
+
+	if response.StatusCode() != http.StatusOK {
+		return fmt.Errorf("[code: %d], %w", response.StatusCode(), ErrInvalidStatusCode)
+	}
+
+	return nil
+}
+
+// OrganizationCreate creates an organization.
+func (c *C) OrganizationCreate(ctx context.Context, name, description string) (*generated.Organization, error) {
+	response, err := c.client.CreateOrganizationWithResponse(
+		ctx,
+		generated.CreateOrganizationJSONRequestBody{Name: name, Description: &description},
+	)
+	if err != nil {
+		return nil, ErrCantMakeRequest
+	}
+
+	if response.StatusCode() == http.StatusConflict {
+		return nil, ErrItemAlreadyExists
+	}
+
+	if response.StatusCode() != http.StatusOK || response.JSON200 == nil {
+		return nil, fmt.Errorf("[code: %d], %w", response.StatusCode(), ErrInvalidStatusCode)
+	}
+
+	return response.JSON200, nil
+}
+
 func (c *C) ProjectExists(name string) (bool, error) {
 	response, err := c.client.ProjectExistsWithResponse(
 		context.Background(),
@@ -165,10 +280,20 @@ func (c *C) ProjectDelete(projectId string) error {
 }
 
 func (c *C) Artifact(projectId string, hash string) (*generated.Artifact, error) {
-	response, err := c.client.GetProjectArtifact(context.Background(), projectId, hash)
+	return c.ArtifactContext(context.Background(), projectId, hash)
+}
+
+// ArtifactContext is like Artifact, the request is canceled with ctx.
+func (c *C) ArtifactContext(ctx context.Context, projectId string, hash string) (*generated.Artifact, error) {
+	response, err := c.client.GetProjectArtifact(ctx, projectId, hash)
 	if err != nil {
 		return nil, ErrCantMakeRequest
 	}
+	defer response.Body.Close()
+
+	if response.StatusCode == http.StatusNotFound {
+		return nil, ErrItemNotFound
+	}
 
 	if response.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf("[code: %d], %w", response.StatusCode, ErrInvalidStatusCode)
@@ -186,6 +311,32 @@ func (c *C) Artifact(projectId string, hash string) (*generated.Artifact, error)
 	return &artifact, nil
 }
 
+// Artifacts lists the ids of all artifacts of a project.
+func (c *C) Artifacts(projectId string) ([]string, error) {
+	return c.ArtifactsContext(context.Background(), projectId)
+}
+
+// ArtifactsContext is like Artifacts, the request is canceled with ctx.
+func (c *C) ArtifactsContext(ctx context.Context, projectId string) ([]string, error) {
+	response, err := c.client.GetProjectArtifactsWithResponse(ctx, projectId)
+	if err != nil {
+		return nil, ErrCantMakeRequest
+	}
+
+	if response.StatusCode() == http.StatusNotFound {
+		return nil, ErrItemNotFound
+	}
+
+	if response.StatusCode() != http.StatusOK || response.JSON200 == nil {
+		return nil, fmt.Errorf("[code: %d], %w", response.StatusCode(), ErrInvalidStatusCode)
+	}
+
+	return *response.JSON200, nil
+}
+
+// ArtifactCreate uploads the file at src. The file is streamed
+// to the server without buffering it in memory. Its digest is sent
+// along, so the server rejects the upload if it arrives corrupted.
 func (c *C) ArtifactCreate(projectId string, hash string, src string) (err error) {
 	defer errz.Recover(&err)
 
@@ -193,28 +344,68 @@ func (c *C) ArtifactCreate(projectId string, hash string, src string) (err error
 	errz.Fatal(err)
 	defer f.Close()
 
-	var b bytes.Buffer
-	w := multipart.NewWriter(&b)
-
-	err = w.WriteField("id", hash)
+	digest, err := fileDigest(f)
 	errz.Fatal(err)
 
-	fieldWriter, err := w.CreateFormFile("file", hash)
-	_, err = io.Copy(fieldWriter, f)
-	errz.Fatal(err)
+	return c.ArtifactCreateFromReader(projectId, hash, digest, f)
+}
+
+// ArtifactCreateFromReader uploads the payload read from src. digest
+// is the expected sha256 checksum of the payload and can be empty.
+func (c *C) ArtifactCreateFromReader(projectId string, hash string, digest string, src io.Reader) (err error) {
+	return c.ArtifactCreateFromReaderContext(context.Background(), projectId, hash, digest, src)
+}
 
-	w.Close()
+// ArtifactCreateFromReaderContext is like ArtifactCreateFromReader,
+// the upload is canceled with ctx.
+func (c *C) ArtifactCreateFromReaderContext(ctx context.Context, projectId string, hash string, digest string, src io.Reader) (err error) {
+	pr, pw := io.Pipe()
+	w := multipart.NewWriter(pw)
+
+	go func() {
+		err := w.WriteField("id", hash)
+		if err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+
+		if digest != "" {
+			err = w.WriteField("digest", digest)
+			if err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+		}
+
+		fieldWriter, err := w.CreateFormFile("file", hash)
+		if err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+
+		_, err = io.Copy(fieldWriter, src)
+		if err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+
+		pw.CloseWithError(w.Close())
+	}()
 
 	response, err := c.client.UploadArtifactWithBodyWithResponse(
-		context.Background(),
+		ctx,
 		projectId,
 		w.FormDataContentType(),
-		&b,
+		pr,
 	)
 	if err != nil {
 		return ErrCantMakeRequest
 	}
 
+	if response.StatusCode() == http.StatusConflict {
+		return ErrItemAlreadyExists
+	}
+
 	if response.StatusCode() != http.StatusOK {
 		return fmt.Errorf("[code: %d], %w", response.StatusCode(), ErrInvalidStatusCode)
 	}
@@ -222,6 +413,272 @@ func (c *C) ArtifactCreate(projectId string, hash string, src string) (err error
 	return nil
 }
 
+// ArtifactsExist checks multiple artifacts with a single request.
+func (c *C) ArtifactsExist(projectId string, hashes []string) (*generated.ArtifactsExist, error) {
+	return c.ArtifactsExistContext(context.Background(), projectId, hashes)
+}
+
+// ArtifactsExistContext is like ArtifactsExist, the request is canceled with ctx.
+func (c *C) ArtifactsExistContext(ctx context.Context, projectId string, hashes []string) (*generated.ArtifactsExist, error) {
+	response, err := c.client.ProjectArtifactsExistWithResponse(
+		ctx,
+		projectId,
+		generated.ProjectArtifactsExistJSONRequestBody(hashes),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("project artifacts exist failed %w", err)
+	}
+
+	if response.StatusCode() == http.StatusNotFound {
+		return nil, ErrItemNotFound
+	}
+
+	if response.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("project artifacts exist failed [code: %d], %w", response.StatusCode(), ErrInvalidStatusCode)
+	}
+
+	return response.JSON200, nil
+}
+
+// MinChunkSize is the minimum size of all but the last chunk of an upload.
+const MinChunkSize = 5 << 20
+
+// UploadCreate starts a resumable upload. digest is the
+// expected sha256 checksum of the payload and can be empty.
+func (c *C) UploadCreate(projectId string, hash string, digest string) (*generated.Upload, error) {
+	body := generated.CreateUploadJSONRequestBody{Id: hash}
+	if digest != "" {
+		body.Digest = &digest
+	}
+
+	response, err := c.client.CreateUploadWithResponse(
+		context.Background(),
+		projectId,
+		body,
+	)
+	if err != nil {
+		return nil, ErrCantMakeRequest
+	}
+
+	if response.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("[code: %d], %w", response.StatusCode(), ErrInvalidStatusCode)
+	}
+
+	return response.JSON200, nil
+}
+
+func (c *C) Upload(projectId string, uploadId string) (*generated.Upload, error) {
+	response, err := c.client.GetUploadWithResponse(context.Background(), projectId, uploadId)
+	if err != nil {
+		return nil, ErrCantMakeRequest
+	}
+
+	if response.StatusCode() == http.StatusNotFound {
+		return nil, ErrItemNotFound
+	}
+
+	if response.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("[code: %d], %w", response.StatusCode(), ErrInvalidStatusCode)
+	}
+
+	return response.JSON200, nil
+}
+
+func (c *C) UploadChunk(projectId string, uploadId string, number int, chunk []byte) error {
+	response, err := c.client.UploadChunkWithBodyWithResponse(
+		context.Background(),
+		projectId,
+		uploadId,
+		number,
+		"application/octet-stream",
+		bytes.NewReader(chunk),
+	)
+	if err != nil {
+		return ErrCantMakeRequest
+	}
+
+	if response.StatusCode() != http.StatusOK {
+		return fmt.Errorf("[code: %d], %w", response.StatusCode(), ErrInvalidStatusCode)
+	}
+
+	return nil
+}
+
+func (c *C) UploadComplete(projectId string, uploadId string) (*generated.Artifact, error) {
+	response, err := c.client.CompleteUploadWithResponse(context.Background(), projectId, uploadId)
+	if err != nil {
+		return nil, ErrCantMakeRequest
+	}
+
+	if response.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("[code: %d], %w", response.StatusCode(), ErrInvalidStatusCode)
+	}
+
+	return response.JSON200, nil
+}
+
+func (c *C) UploadAbort(projectId string, uploadId string) error {
+	response, err := c.client.AbortUpload(context.Background(), projectId, uploadId)
+	if err != nil {
+		return ErrCantMakeRequest
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode == http.StatusNotFound {
+		return ErrItemNotFound
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return ErrInvalidStatusCode
+	}
+
+	return nil
+}
+
+// ArtifactCreateChunked uploads the file at src in chunks of chunkSize bytes.
+// When uploadId is not empty the upload is resumed, chunks already
+// received by the server are skipped.
+func (c *C) ArtifactCreateChunked(projectId string, hash string, src string, chunkSize int, uploadId string) (_ *generated.Artifact, err error) {
+	defer errz.Recover(&err)
+
+	if chunkSize < MinChunkSize {
+		chunkSize = MinChunkSize
+	}
+
+	f, err := os.Open(src)
+	errz.Fatal(err)
+	defer f.Close()
+
+	var u *generated.Upload
+	if uploadId == "" {
+		var digest string
+		digest, err = fileDigest(f)
+		errz.Fatal(err)
+
+		u, err = c.UploadCreate(projectId, hash, digest)
+	} else {
+		u, err = c.Upload(projectId, uploadId)
+	}
+	errz.Fatal(err)
+
+	received := map[int]bool{}
+	for _, chunk := range u.Chunks {
+		received[chunk.Number] = true
+	}
+
+	buf := make([]byte, chunkSize)
+	for number := 1; ; number++ {
+		n, err := io.ReadFull(f, buf)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+			errz.Fatal(err)
+		}
+
+		if !received[number] {
+			err = c.UploadChunk(projectId, u.Id, number, buf[:n])
+			errz.Fatal(err)
+		}
+
+		if n < chunkSize {
+			break
+		}
+	}
+
+	return c.UploadComplete(projectId, u.Id)
+}
+
+// DirectUploadCreate starts an upload directly to the artifact store. digest
+// is the expected sha256 checksum of the payload and can be empty.
+func (c *C) DirectUploadCreate(projectId string, hash string, digest string, parts int) (*generated.DirectUpload, error) {
+	body := generated.CreateDirectUploadJSONRequestBody{Id: hash, Parts: &parts}
+	if digest != "" {
+		body.Digest = &digest
+	}
+
+	response, err := c.client.CreateDirectUploadWithResponse(
+		context.Background(),
+		projectId,
+		body,
+	)
+	if err != nil {
+		return nil, ErrCantMakeRequest
+	}
+
+	if response.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("[code: %d], %w", response.StatusCode(), ErrInvalidStatusCode)
+	}
+
+	return response.JSON200, nil
+}
+
+// ArtifactCreateDirect uploads the file at src directly to the artifact store
+// in parts of chunkSize bytes, bypassing the server.
+func (c *C) ArtifactCreateDirect(projectId string, hash string, src string, chunkSize int64) (_ *generated.Artifact, err error) {
+	defer errz.Recover(&err)
+
+	if chunkSize < MinChunkSize {
+		chunkSize = MinChunkSize
+	}
+
+	f, err := os.Open(src)
+	errz.Fatal(err)
+	defer f.Close()
+
+	fi, err := f.Stat()
+	errz.Fatal(err)
+
+	parts := int((fi.Size() + chunkSize - 1) / chunkSize)
+	if parts == 0 {
+		parts = 1
+	}
+
+	digest, err := fileDigest(f)
+	errz.Fatal(err)
+
+	u, err := c.DirectUploadCreate(projectId, hash, digest, parts)
+	errz.Fatal(err)
+
+	for i, link := range u.Urls {
+		offset := int64(i) * chunkSize
+		size := chunkSize
+		if offset+size > fi.Size() {
+			size = fi.Size() - offset
+		}
+
+		req, err := http.NewRequest(http.MethodPut, link, io.NewSectionReader(f, offset, size))
+		errz.Fatal(err)
+		req.ContentLength = size
+
+		response, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, ErrCantMakeRequest
+		}
+		response.Body.Close()
+
+		if response.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("[code: %d], %w", response.StatusCode, ErrInvalidStatusCode)
+		}
+	}
+
+	return c.UploadComplete(projectId, u.Id)
+}
+
+// fileDigest computes the hex encoded sha256 checksum
+// of f and rewinds it afterwards.
+func fileDigest(f *os.File) (_ string, err error) {
+	defer errz.Recover(&err)
+
+	h := sha256.New()
+	_, err = io.Copy(h, f)
+	errz.Fatal(err)
+
+	_, err = f.Seek(0, io.SeekStart)
+	errz.Fatal(err)
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
 // func (c *C) UpdateHash(projectId string, hash string, update generated.ProjectArtifactUpdate) (*generated.ProjectArtifact, error) {
 // 	body := generated.UpdateProjectArtifactJSONRequestBody(update)
 // 	response, err := c.client.UpdateProjectArtifact(context.Background(), projectId, hash, body)
@@ -252,7 +709,7 @@ func (c *C) ArtifactDelete(projectId string, hash string) error {
 		return ErrCantMakeRequest
 	}
 
-	fmt.Println(response.StatusCode)
+	defer response.Body.Close()
 
 	if response.StatusCode != http.StatusOK {
 		return ErrInvalidStatusCode
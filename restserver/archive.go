@@ -0,0 +1,88 @@
+package restserver
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/benchkram/bobc/application"
+	"github.com/benchkram/bobc/pkg/logging"
+	"github.com/benchkram/bobc/restserver/generated"
+	"github.com/benchkram/errz"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+// ExportProject streams an archive of a project
+// (GET /api/project/{projectName}/export)
+func (s *S) ExportProject(ctx echo.Context, projectName string) (err error) {
+	defer errz.Recover(&err)
+
+	err = s.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+
+	projectID, err := s.app.ProjectIDByName(ctx.Request().Context(), projectName)
+	if errors.Is(err, application.ErrProjectNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound)
+	} else if err != nil {
+		return appError(ctx, err)
+	}
+
+	filename := strings.ReplaceAll(projectName, "/", "_") + ".tar.gz"
+	ctx.Response().Header().Set(echo.HeaderContentType, "application/gzip")
+	ctx.Response().Header().Set(echo.HeaderContentDisposition, "attachment; filename=\""+filename+"\"")
+
+	err = s.app.ProjectExport(ctx.Request().Context(), projectID, ctx.Response())
+	if err != nil {
+		// the status can't be changed once streaming started,
+		// the client notices the truncated archive
+		if ctx.Response().Committed {
+			log.Ctx(ctx.Request().Context()).Error().Err(err).
+				Str(logging.FieldProject, projectName).
+				Msg("Export of project failed.")
+			return nil
+		}
+		return appError(ctx, err)
+	}
+
+	return nil
+}
+
+// ImportProject creates a project and its artifacts from an archive
+// (POST /api/projects/import)
+func (s *S) ImportProject(ctx echo.Context, params generated.ImportProjectParams) (err error) {
+	defer errz.Recover(&err)
+
+	err = s.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+
+	var path string
+	if params.Project != nil {
+		path = *params.Project
+	}
+
+	report, err := s.app.ProjectImport(ctx.Request().Context(), ctx.Request().Body, path)
+	if errors.Is(err, application.ErrInvalidArchive) {
+		return echo.NewHTTPError(http.StatusBadRequest, application.ErrInvalidArchive.Error())
+	} else if errors.Is(err, application.ErrInvalidProjectName) {
+		return echo.NewHTTPError(http.StatusBadRequest, application.ErrInvalidProjectName.Error())
+	} else if errors.Is(err, application.ErrInvalidOrganizationName) {
+		return echo.NewHTTPError(http.StatusBadRequest, application.ErrInvalidOrganizationName.Error())
+	} else if errors.Is(err, application.ErrDigestMismatch) {
+		return echo.NewHTTPError(http.StatusBadRequest, application.ErrDigestMismatch.Error())
+	} else if errors.Is(err, application.ErrQuotaExceeded) {
+		return echo.NewHTTPError(http.StatusInsufficientStorage, application.ErrQuotaExceeded.Error())
+	} else if err != nil {
+		return appError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, generated.ImportReport{
+		Project:  report.Project,
+		Imported: report.Imported,
+		Skipped:  report.Skipped,
+	})
+}
@@ -1,107 +1,130 @@
 package restserver
 
 import (
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
-	"fmt"
 	"io"
 	"io/ioutil"
-	"log"
 	"net/http"
-	"os"
 	"strconv"
 
 	"github.com/benchkram/bobc/application"
+	"github.com/benchkram/bobc/pkg/artifact"
+	"github.com/benchkram/bobc/pkg/logging"
+	"github.com/benchkram/bobc/pkg/metrics"
 	projectRepo "github.com/benchkram/bobc/pkg/projectrepo"
+	"github.com/benchkram/bobc/pkg/scope"
+	"github.com/benchkram/bobc/restserver/generated"
 	"github.com/benchkram/errz"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
 )
 
+// maxArtifactIDLength limits the size of the `id` form field.
+const maxArtifactIDLength = 1024
+
+// maxDigestLength limits the size of the `digest` form field.
+const maxDigestLength = 128
+
 // UploadArtifact creates a new artifact inside a project
 // (POST /api/project/{projectName}/artifacts
 func (s *S) UploadArtifact(ctx echo.Context, projectName string) (err error) {
 	defer errz.Recover(&err)
 
-	err = s.authenticator.Authenticate(ctx)
+	err = s.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = s.readScope(ctx)
 	if err != nil {
-		return ctx.NoContent(http.StatusUnauthorized)
+		return err
 	}
 
-	p, err := s.app.ProjectByName(projectName)
+	p, err := s.app.ProjectByName(ctx.Request().Context(), projectName)
 	if errors.Is(err, application.ErrProjectNotFound) {
 		return echo.NewHTTPError(http.StatusNotFound)
 	} else if err != nil {
-		errz.Log(err)
-		return echo.NewHTTPError(http.StatusInternalServerError)
+		return appError(ctx, err)
 	}
 
-	log.Println("Uploading artifact...", p.ID)
-
 	return s.upload(ctx, p.ID)
 }
 
+// upload streams the multipart body directly to the artifact store.
+// The form fields `id` and `digest` must be sent before the `file` field.
 func (s *S) upload(ctx echo.Context, projectID uuid.UUID) (err error) {
 	defer errz.Recover(&err)
-	println("upload")
 
-	r := ctx.Request()
-	err = r.ParseMultipartForm(0)
+	mr, err := ctx.Request().MultipartReader()
 	if err != nil {
-		errz.Log(err)
-		return echo.NewHTTPError(http.StatusInternalServerError)
-	}
-
-	artifactID := r.FormValue("id")
-	if artifactID == "" {
-		return echo.NewHTTPError(http.StatusBadRequest, "id is empty")
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 	}
 
-	file, _, err := r.FormFile("file")
-	if err != nil {
-		errz.Log(err)
-		return echo.NewHTTPError(http.StatusInternalServerError)
-	}
-
-	f, err := ioutil.TempFile("", "bob-server-upload-*")
-	if err != nil {
-		errz.Log(err)
-		return echo.NewHTTPError(http.StatusInternalServerError)
-	}
+	var artifactID, digest string
+	var a *artifact.A
+	for {
+		part, err := mr.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
 
-	var count int64
-	n, err := io.Copy(f, file)
-	count += n
+		switch part.FormName() {
+		case "id":
+			id, err := ioutil.ReadAll(io.LimitReader(part, maxArtifactIDLength))
+			if err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+			}
+			artifactID = string(id)
+		case "digest":
+			d, err := ioutil.ReadAll(io.LimitReader(part, maxDigestLength))
+			if err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+			}
+			digest = string(d)
+		case "file":
+			if artifactID == "" {
+				return echo.NewHTTPError(http.StatusBadRequest, "id must be sent before file")
+			}
+
+			log.Ctx(ctx.Request().Context()).Debug().
+				Str(logging.FieldProjectID, projectID.String()).
+				Str(logging.FieldArtifactID, artifactID).
+				Msg("Receiving artifact.")
+
+			a, err = s.app.ProjectArtifactCreate(ctx.Request().Context(), projectID, artifactID, digest, part)
+			if err != nil {
+				if errors.Is(err, application.ErrProjectNotFound) {
+					return echo.NewHTTPError(http.StatusNotFound, application.ErrProjectNotFound)
+				} else if errors.Is(err, application.ErrArtifactAlreadyExists) {
+					return echo.NewHTTPError(http.StatusConflict, application.ErrArtifactAlreadyExists)
+				} else if errors.Is(err, application.ErrInvalidDigest) {
+					return echo.NewHTTPError(http.StatusBadRequest, application.ErrInvalidDigest.Error())
+				} else if errors.Is(err, application.ErrDigestMismatch) {
+					return echo.NewHTTPError(http.StatusBadRequest, application.ErrDigestMismatch.Error())
+				} else if errors.Is(err, application.ErrQuotaExceeded) {
+					return echo.NewHTTPError(http.StatusInsufficientStorage, application.ErrQuotaExceeded.Error())
+				} else {
+					return appError(ctx, err)
+				}
+			}
+		}
 
-	fmt.Printf("received... %d", count)
-	if err != nil {
-		errz.Log(err)
-		return echo.NewHTTPError(http.StatusInternalServerError)
+		part.Close()
 	}
 
-	fi, err := f.Stat()
-	if err != nil {
-		errz.Log(err)
-		return echo.NewHTTPError(http.StatusInternalServerError)
+	if artifactID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "id is empty")
 	}
-
-	f.Close()
-	defer os.Remove(f.Name())
-
-	fmt.Printf("Creating artifact: [projectId: %s, artifactId: %s]\n", projectID.String(), artifactID)
-
-	err = s.app.ProjectArtifactCreate(projectID, artifactID, f.Name(), int(fi.Size()))
-	if err != nil {
-		if errors.Is(err, application.ErrProjectNotFound) {
-			return echo.NewHTTPError(http.StatusNotFound, application.ErrProjectNotFound)
-		} else if errors.Is(err, application.ErrArtifactAlreadyExists) {
-			return echo.NewHTTPError(http.StatusConflict, application.ErrArtifactAlreadyExists)
-		} else {
-			errz.Log(err)
-			return echo.NewHTTPError(http.StatusInternalServerError, nil)
-		}
+	if a == nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "file is missing")
 	}
 
-	fmt.Println("Artifact created.")
+	metrics.Upload(a.Size)
 
 	return nil
 }
@@ -112,64 +135,134 @@ func (s *S) upload(ctx echo.Context, projectID uuid.UUID) (err error) {
 func (s *S) ProjectArtifactExists(ctx echo.Context, projectName, artifactId string) (err error) {
 	defer errz.Recover(&err)
 
-	err = s.authenticator.Authenticate(ctx)
+	err = s.authenticateOrAnonymous(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = s.readScope(ctx)
 	if err != nil {
-		return ctx.NoContent(http.StatusUnauthorized)
+		return err
 	}
 
-	p, err := s.app.ProjectByName(projectName)
+	p, err := s.app.ProjectByName(ctx.Request().Context(), projectName)
 	if errors.Is(err, application.ErrProjectNotFound) {
 		return echo.NewHTTPError(http.StatusNotFound)
 	} else if err != nil {
-		errz.Log(err)
-		return echo.NewHTTPError(http.StatusInternalServerError)
+		return appError(ctx, err)
 	}
 
-	exists, err := s.app.ProjectArtifactExists(p.ID, artifactId)
+	exists, sc, err := s.app.ProjectArtifactExists(ctx.Request().Context(), p.ID, artifactId)
 	if err != nil {
 		if errors.Is(err, projectRepo.ErrNotFound) {
 			return ctx.JSON(http.StatusNotFound, nil)
 		} else {
-			errz.Log(err)
-			return ctx.JSON(http.StatusInternalServerError, nil)
+			return appError(ctx, err)
 		}
 	}
 
+	metrics.Exists(exists)
+
 	ctx.Response().Header().Set(HeaderBobExists, strconv.FormatBool(exists))
+	setScopeHeader(ctx, sc)
 
 	return ctx.JSON(http.StatusOK, nil)
 }
 
+// ProjectArtifactsExist reports which of the requested artifacts exist under a project.
+// (POST /api/project/{projectName}/artifacts/exists)
+func (s *S) ProjectArtifactsExist(ctx echo.Context, projectName string) (err error) {
+	defer errz.Recover(&err)
+
+	err = s.authenticateOrAnonymous(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = s.readScope(ctx)
+	if err != nil {
+		return err
+	}
+
+	artifactIDs := generated.ArtifactIds{}
+	err = ctx.Bind(&artifactIDs)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, nil)
+	}
+
+	projectID, err := s.app.ProjectIDByName(ctx.Request().Context(), projectName)
+	if errors.Is(err, application.ErrProjectNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound)
+	} else if err != nil {
+		return appError(ctx, err)
+	}
+
+	present, missing, scopes, err := s.app.ProjectArtifactsExist(ctx.Request().Context(), projectID, artifactIDs)
+	if errors.Is(err, application.ErrTooManyArtifacts) {
+		return echo.NewHTTPError(http.StatusBadRequest, application.ErrTooManyArtifacts)
+	} else if err != nil {
+		return appError(ctx, err)
+	}
+
+	metrics.ExistsBatch(len(present), len(missing))
+
+	result := generated.ArtifactsExist{
+		Present: present,
+		Missing: missing,
+	}
+
+	// the default scope isn't reported
+	for id, sc := range scopes {
+		if sc == scope.Default {
+			continue
+		}
+		if result.Scopes == nil {
+			result.Scopes = &generated.ArtifactsExist_Scopes{}
+		}
+		result.Scopes.Set(id, sc)
+	}
+
+	return ctx.JSON(http.StatusOK, result)
+}
+
 // GetProjectArtifact returns specific project artifact
 // (GET /api/project/{projectName}/artifact/{artifactId})
 func (s *S) GetProjectArtifact(ctx echo.Context, projectName, artifactId string) (err error) {
 	defer errz.Recover(&err)
 
-	err = s.authenticator.Authenticate(ctx)
+	err = s.authenticateOrAnonymous(ctx)
 	if err != nil {
-		return ctx.NoContent(http.StatusUnauthorized)
+		return err
 	}
 
-	p, err := s.app.ProjectByName(projectName)
+	err = s.readScope(ctx)
+	if err != nil {
+		return err
+	}
+
+	p, err := s.app.ProjectByName(ctx.Request().Context(), projectName)
 	if errors.Is(err, application.ErrProjectNotFound) {
 		return echo.NewHTTPError(http.StatusNotFound)
 	} else if err != nil {
-		errz.Log(err)
-		return echo.NewHTTPError(http.StatusInternalServerError)
+		return appError(ctx, err)
 	}
 
-	h, err := s.app.ProjectArtifact(p.ID, artifactId)
+	h, err := s.app.ProjectArtifact(ctx.Request().Context(), p.ID, artifactId)
 	if err != nil {
 		if errors.Is(err, projectRepo.ErrNotFound) {
 			return ctx.JSON(http.StatusNotFound, nil)
 		} else if errors.Is(err, projectRepo.ErrNotFound) {
 			return ctx.JSON(http.StatusNotFound, nil)
 		} else {
-			errz.Log(err)
-			return ctx.JSON(http.StatusInternalServerError, nil)
+			return appError(ctx, err)
 		}
 	}
 
+	if h.Digest != "" {
+		setDigestHeaders(ctx, h.Digest)
+	}
+	setScopeHeader(ctx, h.Scope)
+
 	return ctx.JSON(http.StatusOK, h.ToRestType())
 }
 
@@ -178,21 +271,26 @@ func (s *S) GetProjectArtifact(ctx echo.Context, projectName, artifactId string)
 func (s *S) GetProjectArtifacts(ctx echo.Context, projectName string) (err error) {
 	defer errz.Recover(&err)
 
-	err = s.authenticator.Authenticate(ctx)
+	err = s.authenticateOrAnonymous(ctx)
 	if err != nil {
-		return ctx.NoContent(http.StatusUnauthorized)
+		return err
 	}
 
-	p, err := s.app.ProjectByName(projectName)
+	p, err := s.app.ProjectByName(ctx.Request().Context(), projectName)
 	if errors.Is(err, application.ErrProjectNotFound) {
 		return echo.NewHTTPError(http.StatusNotFound)
 	} else if err != nil {
-		errz.Log(err)
-		return echo.NewHTTPError(http.StatusInternalServerError)
+		return appError(ctx, err)
 	}
 
+	// artifacts can exist in multiple scopes
 	ids := []string{}
+	seen := map[string]bool{}
 	for _, a := range p.Artifacts {
+		if seen[a.ID] {
+			continue
+		}
+		seen[a.ID] = true
 		ids = append(ids, a.ID)
 	}
 
@@ -204,28 +302,43 @@ func (s *S) GetProjectArtifacts(ctx echo.Context, projectName string) (err error
 func (s *S) DeleteProjectArtifact(ctx echo.Context, projectName, artifactId string) (err error) {
 	defer errz.Recover(&err)
 
-	err = s.authenticator.Authenticate(ctx)
+	err = s.authenticate(ctx)
 	if err != nil {
-		return ctx.NoContent(http.StatusUnauthorized)
+		return err
 	}
 
-	p, err := s.app.ProjectByName(projectName)
+	err = s.readScope(ctx)
+	if err != nil {
+		return err
+	}
+
+	p, err := s.app.ProjectByName(ctx.Request().Context(), projectName)
 	if errors.Is(err, application.ErrProjectNotFound) {
 		return echo.NewHTTPError(http.StatusNotFound)
 	} else if err != nil {
-		errz.Log(err)
-		return echo.NewHTTPError(http.StatusInternalServerError)
+		return appError(ctx, err)
 	}
 
-	err = s.app.ProjectArtifactDelete(p.ID, artifactId)
+	err = s.app.ProjectArtifactDelete(ctx.Request().Context(), p.ID, artifactId)
 	if err != nil {
 		if errors.Is(err, projectRepo.ErrNotFound) {
 			return ctx.JSON(http.StatusNotFound, nil)
 		} else {
-			errz.Log(err)
-			return ctx.JSON(http.StatusInternalServerError, nil)
+			return appError(ctx, err)
 		}
 	}
 
 	return ctx.JSON(http.StatusOK, nil)
 }
+
+// setDigestHeaders announces the sha256 checksum of an artifact's payload
+// as `Digest` header (RFC 3230) and as `ETag`.
+func setDigestHeaders(ctx echo.Context, digest string) {
+	sum, err := hex.DecodeString(digest)
+	if err != nil {
+		return
+	}
+
+	ctx.Response().Header().Set(HeaderDigest, "sha-256="+base64.StdEncoding.EncodeToString(sum))
+	ctx.Response().Header().Set(HeaderETag, strconv.Quote(digest))
+}
@@ -1,39 +1,89 @@
 package authenticator
 
 import (
+	"context"
 	"crypto/subtle"
 	"errors"
 	"strings"
 
+	"github.com/benchkram/bobc/pkg/principal"
+	"github.com/benchkram/bobc/pkg/token"
+	"github.com/benchkram/bobc/restserver"
 	"github.com/benchkram/errz"
 	"github.com/labstack/echo/v4"
 )
 
+// Tokens verifies api tokens.
+type Tokens interface {
+	TokenAuthenticate(secret string) (*principal.P, error)
+}
+
+// OIDC verifies JWTs issued by an OIDC provider.
+type OIDC interface {
+	Verify(ctx context.Context, raw string) (*principal.P, error)
+}
+
 type Authenticator struct {
+	// apiKey grants full access, disabled when empty
 	apiKey []byte
+
+	// tokens is optional, api tokens are rejected if not set
+	tokens Tokens
+
+	// oidc is optional, JWTs are rejected if not set
+	oidc OIDC
 }
 
-func New(apiKey []byte) *Authenticator {
-	return &Authenticator{
+func New(apiKey []byte, opts ...Option) *Authenticator {
+	a := &Authenticator{
 		apiKey: apiKey,
 	}
+
+	for _, opt := range opts {
+		if opt != nil {
+			opt(a)
+		}
+	}
+
+	return a
 }
 
-func (a *Authenticator) Authenticate(ctx echo.Context) (err error) {
+// Authenticate returns the principal of a request carrying the static
+// api key, an api token or a JWT of the configured OIDC provider.
+// Authorization is left to the application.
+func (a *Authenticator) Authenticate(ctx echo.Context) (_ *principal.P, err error) {
 	defer errz.Recover(&err)
 
-	token, err := a.extractTokenFromRequest(ctx)
+	secret, err := a.extractTokenFromRequest(ctx)
 	errz.Fatal(err)
 
-	return a.extractUserFromToken(token)
-}
+	if len(a.apiKey) > 0 && subtle.ConstantTimeCompare([]byte(secret), a.apiKey) == 1 {
+		return principal.Admin("api-key"), nil
+	}
+
+	if token.IsToken(secret) {
+		if a.tokens == nil {
+			return nil, restserver.ErrUnauthorized
+		}
+
+		p, err := a.tokens.TokenAuthenticate(secret)
+		if err != nil {
+			return nil, restserver.ErrUnauthorized
+		}
+
+		return p, nil
+	}
+
+	if a.oidc == nil {
+		return nil, restserver.ErrUnauthorized
+	}
 
-func (a *Authenticator) extractUserFromToken(token string) error {
-	if subtle.ConstantTimeCompare([]byte(token), a.apiKey) != 1 {
-		return errors.New("invalid token")
+	p, err := a.oidc.Verify(ctx.Request().Context(), secret)
+	if err != nil {
+		return nil, restserver.ErrUnauthorized
 	}
 
-	return nil
+	return p, nil
 }
 
 func (a *Authenticator) extractTokenFromRequest(ctx echo.Context) (token string, err error) {
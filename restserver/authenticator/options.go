@@ -0,0 +1,17 @@
+package authenticator
+
+type Option func(a *Authenticator)
+
+// WithTokens enables authentication with api tokens.
+func WithTokens(tokens Tokens) Option {
+	return func(a *Authenticator) {
+		a.tokens = tokens
+	}
+}
+
+// WithOIDC enables authentication with JWTs of an OIDC provider.
+func WithOIDC(oidc OIDC) Option {
+	return func(a *Authenticator) {
+		a.oidc = oidc
+	}
+}
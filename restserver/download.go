@@ -0,0 +1,46 @@
+package restserver
+
+import (
+	"errors"
+	"net/http"
+	"os"
+
+	"github.com/benchkram/bobc/restserver/generated"
+	"github.com/benchkram/errz"
+	"github.com/labstack/echo/v4"
+)
+
+// DownloadArtifact serves an artifact from the local filesystem. Access is granted
+// by the signature of the link, no authentication required.
+// (GET /api/download/{objectId})
+func (s *S) DownloadArtifact(ctx echo.Context, objectId string, params generated.DownloadArtifactParams) (err error) {
+	defer errz.Recover(&err)
+
+	if s.downloader == nil {
+		return ctx.NoContent(http.StatusNotFound)
+	}
+
+	err = s.downloader.VerifyLink(objectId, params.Expires, params.Signature)
+	if err != nil {
+		return ctx.NoContent(http.StatusForbidden)
+	}
+
+	f, err := s.downloader.Open(objectId)
+	if errors.Is(err, os.ErrNotExist) {
+		return ctx.NoContent(http.StatusNotFound)
+	} else if err != nil {
+		return appError(ctx, err)
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return appError(ctx, err)
+	}
+
+	ctx.Response().Header().Set(echo.HeaderContentType, "application/tar+gzip")
+	ctx.Response().Header().Set(echo.HeaderContentDisposition, "attachment; filename=\""+objectId+"\"")
+	http.ServeContent(ctx.Response(), ctx.Request(), objectId, fi.ModTime(), f)
+
+	return nil
+}
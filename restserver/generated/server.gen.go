@@ -13,9 +13,39 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Download an artifact through a signed link.
+	// (GET /api/download/{objectId})
+	DownloadArtifact(ctx echo.Context, objectId string, params DownloadArtifactParams) error
 	// Returns the health status of the server
 	// (GET /api/health)
 	GetHealth(ctx echo.Context) error
+	// Liveness probe
+	// (GET /api/health/live)
+	GetLiveness(ctx echo.Context) error
+	// Readiness probe
+	// (GET /api/health/ready)
+	GetReadiness(ctx echo.Context) error
+	// Delete an organization.
+	// (DELETE /api/organization/{organizationName})
+	DeleteOrganization(ctx echo.Context, organizationName string) error
+	// Returns a single organization.
+	// (GET /api/organization/{organizationName})
+	GetOrganization(ctx echo.Context, organizationName string) error
+	// Remove a member.
+	// (DELETE /api/organization/{organizationName}/member/{userName})
+	RemoveMember(ctx echo.Context, organizationName string, userName string) error
+	// Add a member or change its role.
+	// (PUT /api/organization/{organizationName}/member/{userName})
+	SetMember(ctx echo.Context, organizationName string, userName string) error
+	// Returns the members of an organization.
+	// (GET /api/organization/{organizationName}/members)
+	GetMembers(ctx echo.Context, organizationName string) error
+	// Returns a list of organizations.
+	// (GET /api/organizations)
+	GetOrganizations(ctx echo.Context) error
+	// Create a new organization.
+	// (POST /api/organizations)
+	CreateOrganization(ctx echo.Context) error
 	// Delete a project by id.
 	// (DELETE /api/project/{projectName})
 	DeleteProject(ctx echo.Context, projectName string) error
@@ -40,12 +70,75 @@ type ServerInterface interface {
 	// Upload a artifact and assign it to a project.
 	// (POST /api/project/{projectName}/artifacts)
 	UploadArtifact(ctx echo.Context, projectName string) error
+	// Check if multiple artifacts exist.
+	// (POST /api/project/{projectName}/artifacts/exists)
+	ProjectArtifactsExist(ctx echo.Context, projectName string) error
+	// Start an upload directly to the artifact store.
+	// (POST /api/project/{projectName}/direct-uploads)
+	CreateDirectUpload(ctx echo.Context, projectName string) error
+	// Export a project as archive.
+	// (GET /api/project/{projectName}/export)
+	ExportProject(ctx echo.Context, projectName string) error
+	// Get the storage quota of a project.
+	// (GET /api/project/{projectName}/quota)
+	GetQuota(ctx echo.Context, projectName string) error
+	// Set the storage quota of a project.
+	// (PUT /api/project/{projectName}/quota)
+	SetQuota(ctx echo.Context, projectName string) error
+	// Get the retention policy of a project.
+	// (GET /api/project/{projectName}/retention)
+	GetRetentionPolicy(ctx echo.Context, projectName string) error
+	// Set the retention policy of a project.
+	// (PUT /api/project/{projectName}/retention)
+	SetRetentionPolicy(ctx echo.Context, projectName string) error
+	// Abort an upload session.
+	// (DELETE /api/project/{projectName}/upload/{uploadId})
+	AbortUpload(ctx echo.Context, projectName string, uploadId string) error
+	// Get an upload session.
+	// (GET /api/project/{projectName}/upload/{uploadId})
+	GetUpload(ctx echo.Context, projectName string, uploadId string) error
+	// Upload a chunk of an artifact.
+	// (PUT /api/project/{projectName}/upload/{uploadId}/chunk/{chunkNumber})
+	UploadChunk(ctx echo.Context, projectName string, uploadId string, chunkNumber int) error
+	// Complete an upload session.
+	// (POST /api/project/{projectName}/upload/{uploadId}/complete)
+	CompleteUpload(ctx echo.Context, projectName string, uploadId string) error
+	// Start a resumable upload of an artifact.
+	// (POST /api/project/{projectName}/uploads)
+	CreateUpload(ctx echo.Context, projectName string) error
+	// Make a project public or private.
+	// (PUT /api/project/{projectName}/visibility)
+	SetVisibility(ctx echo.Context, projectName string) error
 	// Returns a list of projects.
 	// (GET /api/projects)
 	GetProjects(ctx echo.Context) error
 	// Create a new project.
 	// (POST /api/projects)
 	CreateProject(ctx echo.Context) error
+	// Import a project from an archive.
+	// (POST /api/projects/import)
+	ImportProject(ctx echo.Context, params ImportProjectParams) error
+	// Revoke an api token.
+	// (DELETE /api/token/{tokenId})
+	RevokeToken(ctx echo.Context, tokenId string) error
+	// Returns a list of api tokens.
+	// (GET /api/tokens)
+	GetTokens(ctx echo.Context) error
+	// Create a new api token.
+	// (POST /api/tokens)
+	CreateToken(ctx echo.Context) error
+	// Delete a user.
+	// (DELETE /api/user/{userName})
+	DeleteUser(ctx echo.Context, userName string) error
+	// Returns a list of users.
+	// (GET /api/users)
+	GetUsers(ctx echo.Context) error
+	// Create a new user.
+	// (POST /api/users)
+	CreateUser(ctx echo.Context) error
+	// Returns metrics of the server
+	// (GET /metrics)
+	GetMetrics(ctx echo.Context) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -53,6 +146,38 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// DownloadArtifact converts echo context to params.
+func (w *ServerInterfaceWrapper) DownloadArtifact(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "objectId" -------------
+	var objectId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "objectId", runtime.ParamLocationPath, ctx.Param("objectId"), &objectId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter objectId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DownloadArtifactParams
+	// ------------- Required query parameter "expires" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "expires", ctx.QueryParams(), &params.Expires)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter expires: %s", err))
+	}
+
+	// ------------- Required query parameter "signature" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "signature", ctx.QueryParams(), &params.Signature)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter signature: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DownloadArtifact(ctx, objectId, params)
+	return err
+}
+
 // GetHealth converts echo context to params.
 func (w *ServerInterfaceWrapper) GetHealth(ctx echo.Context) error {
 	var err error
@@ -62,6 +187,138 @@ func (w *ServerInterfaceWrapper) GetHealth(ctx echo.Context) error {
 	return err
 }
 
+// GetLiveness converts echo context to params.
+func (w *ServerInterfaceWrapper) GetLiveness(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetLiveness(ctx)
+	return err
+}
+
+// GetReadiness converts echo context to params.
+func (w *ServerInterfaceWrapper) GetReadiness(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetReadiness(ctx)
+	return err
+}
+
+// DeleteOrganization converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteOrganization(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "organizationName" -------------
+	var organizationName string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "organizationName", runtime.ParamLocationPath, ctx.Param("organizationName"), &organizationName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter organizationName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeleteOrganization(ctx, organizationName)
+	return err
+}
+
+// GetOrganization converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOrganization(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "organizationName" -------------
+	var organizationName string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "organizationName", runtime.ParamLocationPath, ctx.Param("organizationName"), &organizationName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter organizationName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetOrganization(ctx, organizationName)
+	return err
+}
+
+// RemoveMember converts echo context to params.
+func (w *ServerInterfaceWrapper) RemoveMember(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "organizationName" -------------
+	var organizationName string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "organizationName", runtime.ParamLocationPath, ctx.Param("organizationName"), &organizationName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter organizationName: %s", err))
+	}
+
+	// ------------- Path parameter "userName" -------------
+	var userName string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "userName", runtime.ParamLocationPath, ctx.Param("userName"), &userName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.RemoveMember(ctx, organizationName, userName)
+	return err
+}
+
+// SetMember converts echo context to params.
+func (w *ServerInterfaceWrapper) SetMember(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "organizationName" -------------
+	var organizationName string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "organizationName", runtime.ParamLocationPath, ctx.Param("organizationName"), &organizationName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter organizationName: %s", err))
+	}
+
+	// ------------- Path parameter "userName" -------------
+	var userName string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "userName", runtime.ParamLocationPath, ctx.Param("userName"), &userName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SetMember(ctx, organizationName, userName)
+	return err
+}
+
+// GetMembers converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMembers(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "organizationName" -------------
+	var organizationName string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "organizationName", runtime.ParamLocationPath, ctx.Param("organizationName"), &organizationName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter organizationName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetMembers(ctx, organizationName)
+	return err
+}
+
+// GetOrganizations converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOrganizations(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetOrganizations(ctx)
+	return err
+}
+
+// CreateOrganization converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateOrganization(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.CreateOrganization(ctx)
+	return err
+}
+
 // DeleteProject converts echo context to params.
 func (w *ServerInterfaceWrapper) DeleteProject(ctx echo.Context) error {
 	var err error
@@ -214,6 +471,254 @@ func (w *ServerInterfaceWrapper) UploadArtifact(ctx echo.Context) error {
 	return err
 }
 
+// ProjectArtifactsExist converts echo context to params.
+func (w *ServerInterfaceWrapper) ProjectArtifactsExist(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "projectName", runtime.ParamLocationPath, ctx.Param("projectName"), &projectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ProjectArtifactsExist(ctx, projectName)
+	return err
+}
+
+// CreateDirectUpload converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateDirectUpload(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "projectName", runtime.ParamLocationPath, ctx.Param("projectName"), &projectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.CreateDirectUpload(ctx, projectName)
+	return err
+}
+
+// ExportProject converts echo context to params.
+func (w *ServerInterfaceWrapper) ExportProject(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "projectName", runtime.ParamLocationPath, ctx.Param("projectName"), &projectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ExportProject(ctx, projectName)
+	return err
+}
+
+// GetQuota converts echo context to params.
+func (w *ServerInterfaceWrapper) GetQuota(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "projectName", runtime.ParamLocationPath, ctx.Param("projectName"), &projectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetQuota(ctx, projectName)
+	return err
+}
+
+// SetQuota converts echo context to params.
+func (w *ServerInterfaceWrapper) SetQuota(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "projectName", runtime.ParamLocationPath, ctx.Param("projectName"), &projectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SetQuota(ctx, projectName)
+	return err
+}
+
+// GetRetentionPolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRetentionPolicy(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "projectName", runtime.ParamLocationPath, ctx.Param("projectName"), &projectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetRetentionPolicy(ctx, projectName)
+	return err
+}
+
+// SetRetentionPolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) SetRetentionPolicy(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "projectName", runtime.ParamLocationPath, ctx.Param("projectName"), &projectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SetRetentionPolicy(ctx, projectName)
+	return err
+}
+
+// AbortUpload converts echo context to params.
+func (w *ServerInterfaceWrapper) AbortUpload(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "projectName", runtime.ParamLocationPath, ctx.Param("projectName"), &projectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// ------------- Path parameter "uploadId" -------------
+	var uploadId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "uploadId", runtime.ParamLocationPath, ctx.Param("uploadId"), &uploadId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter uploadId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AbortUpload(ctx, projectName, uploadId)
+	return err
+}
+
+// GetUpload converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUpload(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "projectName", runtime.ParamLocationPath, ctx.Param("projectName"), &projectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// ------------- Path parameter "uploadId" -------------
+	var uploadId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "uploadId", runtime.ParamLocationPath, ctx.Param("uploadId"), &uploadId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter uploadId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetUpload(ctx, projectName, uploadId)
+	return err
+}
+
+// UploadChunk converts echo context to params.
+func (w *ServerInterfaceWrapper) UploadChunk(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "projectName", runtime.ParamLocationPath, ctx.Param("projectName"), &projectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// ------------- Path parameter "uploadId" -------------
+	var uploadId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "uploadId", runtime.ParamLocationPath, ctx.Param("uploadId"), &uploadId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter uploadId: %s", err))
+	}
+
+	// ------------- Path parameter "chunkNumber" -------------
+	var chunkNumber int
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "chunkNumber", runtime.ParamLocationPath, ctx.Param("chunkNumber"), &chunkNumber)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter chunkNumber: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UploadChunk(ctx, projectName, uploadId, chunkNumber)
+	return err
+}
+
+// CompleteUpload converts echo context to params.
+func (w *ServerInterfaceWrapper) CompleteUpload(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "projectName", runtime.ParamLocationPath, ctx.Param("projectName"), &projectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// ------------- Path parameter "uploadId" -------------
+	var uploadId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "uploadId", runtime.ParamLocationPath, ctx.Param("uploadId"), &uploadId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter uploadId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.CompleteUpload(ctx, projectName, uploadId)
+	return err
+}
+
+// CreateUpload converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateUpload(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "projectName", runtime.ParamLocationPath, ctx.Param("projectName"), &projectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.CreateUpload(ctx, projectName)
+	return err
+}
+
+// SetVisibility converts echo context to params.
+func (w *ServerInterfaceWrapper) SetVisibility(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectName" -------------
+	var projectName string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "projectName", runtime.ParamLocationPath, ctx.Param("projectName"), &projectName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SetVisibility(ctx, projectName)
+	return err
+}
+
 // GetProjects converts echo context to params.
 func (w *ServerInterfaceWrapper) GetProjects(ctx echo.Context) error {
 	var err error
@@ -232,6 +737,101 @@ func (w *ServerInterfaceWrapper) CreateProject(ctx echo.Context) error {
 	return err
 }
 
+// ImportProject converts echo context to params.
+func (w *ServerInterfaceWrapper) ImportProject(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportProjectParams
+	// ------------- Optional query parameter "project" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "project", ctx.QueryParams(), &params.Project)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ImportProject(ctx, params)
+	return err
+}
+
+// RevokeToken converts echo context to params.
+func (w *ServerInterfaceWrapper) RevokeToken(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tokenId" -------------
+	var tokenId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tokenId", runtime.ParamLocationPath, ctx.Param("tokenId"), &tokenId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tokenId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.RevokeToken(ctx, tokenId)
+	return err
+}
+
+// GetTokens converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTokens(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetTokens(ctx)
+	return err
+}
+
+// CreateToken converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateToken(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.CreateToken(ctx)
+	return err
+}
+
+// DeleteUser converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteUser(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "userName" -------------
+	var userName string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "userName", runtime.ParamLocationPath, ctx.Param("userName"), &userName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeleteUser(ctx, userName)
+	return err
+}
+
+// GetUsers converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsers(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetUsers(ctx)
+	return err
+}
+
+// CreateUser converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateUser(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.CreateUser(ctx)
+	return err
+}
+
+// GetMetrics converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMetrics(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetMetrics(ctx)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -260,7 +860,17 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/api/download/:objectId", wrapper.DownloadArtifact)
 	router.GET(baseURL+"/api/health", wrapper.GetHealth)
+	router.GET(baseURL+"/api/health/live", wrapper.GetLiveness)
+	router.GET(baseURL+"/api/health/ready", wrapper.GetReadiness)
+	router.DELETE(baseURL+"/api/organization/:organizationName", wrapper.DeleteOrganization)
+	router.GET(baseURL+"/api/organization/:organizationName", wrapper.GetOrganization)
+	router.DELETE(baseURL+"/api/organization/:organizationName/member/:userName", wrapper.RemoveMember)
+	router.PUT(baseURL+"/api/organization/:organizationName/member/:userName", wrapper.SetMember)
+	router.GET(baseURL+"/api/organization/:organizationName/members", wrapper.GetMembers)
+	router.GET(baseURL+"/api/organizations", wrapper.GetOrganizations)
+	router.POST(baseURL+"/api/organizations", wrapper.CreateOrganization)
 	router.DELETE(baseURL+"/api/project/:projectName", wrapper.DeleteProject)
 	router.GET(baseURL+"/api/project/:projectName", wrapper.GetProject)
 	router.HEAD(baseURL+"/api/project/:projectName", wrapper.ProjectExists)
@@ -269,8 +879,29 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.HEAD(baseURL+"/api/project/:projectName/artifact/:artifactId", wrapper.ProjectArtifactExists)
 	router.GET(baseURL+"/api/project/:projectName/artifacts", wrapper.GetProjectArtifacts)
 	router.POST(baseURL+"/api/project/:projectName/artifacts", wrapper.UploadArtifact)
+	router.POST(baseURL+"/api/project/:projectName/artifacts/exists", wrapper.ProjectArtifactsExist)
+	router.POST(baseURL+"/api/project/:projectName/direct-uploads", wrapper.CreateDirectUpload)
+	router.GET(baseURL+"/api/project/:projectName/export", wrapper.ExportProject)
+	router.GET(baseURL+"/api/project/:projectName/quota", wrapper.GetQuota)
+	router.PUT(baseURL+"/api/project/:projectName/quota", wrapper.SetQuota)
+	router.GET(baseURL+"/api/project/:projectName/retention", wrapper.GetRetentionPolicy)
+	router.PUT(baseURL+"/api/project/:projectName/retention", wrapper.SetRetentionPolicy)
+	router.DELETE(baseURL+"/api/project/:projectName/upload/:uploadId", wrapper.AbortUpload)
+	router.GET(baseURL+"/api/project/:projectName/upload/:uploadId", wrapper.GetUpload)
+	router.PUT(baseURL+"/api/project/:projectName/upload/:uploadId/chunk/:chunkNumber", wrapper.UploadChunk)
+	router.POST(baseURL+"/api/project/:projectName/upload/:uploadId/complete", wrapper.CompleteUpload)
+	router.POST(baseURL+"/api/project/:projectName/uploads", wrapper.CreateUpload)
+	router.PUT(baseURL+"/api/project/:projectName/visibility", wrapper.SetVisibility)
 	router.GET(baseURL+"/api/projects", wrapper.GetProjects)
 	router.POST(baseURL+"/api/projects", wrapper.CreateProject)
+	router.POST(baseURL+"/api/projects/import", wrapper.ImportProject)
+	router.DELETE(baseURL+"/api/token/:tokenId", wrapper.RevokeToken)
+	router.GET(baseURL+"/api/tokens", wrapper.GetTokens)
+	router.POST(baseURL+"/api/tokens", wrapper.CreateToken)
+	router.DELETE(baseURL+"/api/user/:userName", wrapper.DeleteUser)
+	router.GET(baseURL+"/api/users", wrapper.GetUsers)
+	router.POST(baseURL+"/api/users", wrapper.CreateUser)
+	router.GET(baseURL+"/metrics", wrapper.GetMetrics)
 
 }
 
@@ -3,18 +3,77 @@
 // Code generated by github.com/deepmap/oapi-codegen DO NOT EDIT.
 package generated
 
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
 // Artifact defines model for Artifact.
 type Artifact struct {
-	Id string `json:"id"`
+
+	// hex encoded sha256 checksum of the payload. Missing for
+	// direct uploads and artifacts uploaded before digests
+	// were recorded.
+	Digest *string `json:"digest,omitempty"`
+	Id     string  `json:"id"`
 
 	// location to download the artifact using a GET request.
 	Location *string `json:"location,omitempty"`
-	Size     int     `json:"size"`
+
+	// scope the artifact was uploaded to, missing for the default scope
+	Scope *string `json:"scope,omitempty"`
+	Size  int     `json:"size"`
+}
+
+// ArtifactCreate defines model for ArtifactCreate.
+type ArtifactCreate struct {
+
+	// expected hex encoded sha256 checksum of the payload
+	Digest *string `json:"digest,omitempty"`
+	Id     string  `json:"id"`
 }
 
 // ArtifactIds defines model for ArtifactIds.
 type ArtifactIds []string
 
+// ArtifactsExist defines model for ArtifactsExist.
+type ArtifactsExist struct {
+	Missing []string `json:"missing"`
+	Present []string `json:"present"`
+
+	// scope each present artifact was found in, missing for the default scope
+	Scopes *ArtifactsExist_Scopes `json:"scopes,omitempty"`
+}
+
+// scope each present artifact was found in, missing for the default scope
+type ArtifactsExist_Scopes struct {
+	AdditionalProperties map[string]string `json:"-"`
+}
+
+// DirectUpload defines model for DirectUpload.
+type DirectUpload struct {
+	ArtifactId string    `json:"artifactId"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+	Id         string    `json:"id"`
+
+	// presigned links to PUT the parts to, ordered by part number
+	Urls []string `json:"urls"`
+}
+
+// DirectUploadCreate defines model for DirectUploadCreate.
+type DirectUploadCreate struct {
+
+	// expected hex encoded sha256 checksum of the payload
+	Digest *string `json:"digest,omitempty"`
+	Id     string  `json:"id"`
+
+	// number of parts the payload is uploaded in, defaults to 1
+	Parts *int `json:"parts,omitempty"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	Id string `json:"id"`
@@ -26,6 +85,84 @@ type ExtendedProject struct {
 	Hashes      *[]Artifact `json:"hashes,omitempty"`
 	Id          string      `json:"id"`
 	Name        string      `json:"name"`
+
+	// name of the organization owning the project
+	Organization string `json:"organization"`
+
+	// project path `organization/project` as referenced by bob
+	Path string `json:"path"`
+
+	// public projects can be read without credentials
+	Public bool          `json:"public"`
+	Usage  *ProjectUsage `json:"usage,omitempty"`
+}
+
+// Health defines model for Health.
+type Health struct {
+	Checks *[]HealthCheck `json:"checks,omitempty"`
+
+	// up or down, down if any check is down
+	Status string `json:"status"`
+}
+
+// HealthCheck defines model for HealthCheck.
+type HealthCheck struct {
+
+	// why the check failed
+	Error *string `json:"error,omitempty"`
+
+	// time the check took in milliseconds
+	LatencyMs float64 `json:"latencyMs"`
+
+	// the dependency checked, e.g. database
+	Name string `json:"name"`
+
+	// up or down
+	Status string `json:"status"`
+}
+
+// ImportReport defines model for ImportReport.
+type ImportReport struct {
+
+	// number of artifacts created
+	Imported int `json:"imported"`
+
+	// path of the project imported to
+	Project string `json:"project"`
+
+	// number of artifacts which already existed
+	Skipped int `json:"skipped"`
+}
+
+// Member defines model for Member.
+type Member struct {
+
+	// member to download artifacts, maintainer to upload and delete them, owner to manage projects and members of the organization
+	Role Role `json:"role"`
+
+	// name of the user
+	User string `json:"user"`
+}
+
+// MemberSet defines model for MemberSet.
+type MemberSet struct {
+
+	// member to download artifacts, maintainer to upload and delete them, owner to manage projects and members of the organization
+	Role Role `json:"role"`
+}
+
+// Organization defines model for Organization.
+type Organization struct {
+	CreatedAt   time.Time `json:"createdAt"`
+	Description string    `json:"description"`
+	Id          string    `json:"id"`
+	Name        string    `json:"name"`
+}
+
+// OrganizationCreate defines model for OrganizationCreate.
+type OrganizationCreate struct {
+	Description *string `json:"description,omitempty"`
+	Name        string  `json:"name"`
 }
 
 // Project defines model for Project.
@@ -33,22 +170,297 @@ type Project struct {
 	Description string `json:"description"`
 	Id          string `json:"id"`
 	Name        string `json:"name"`
+
+	// name of the organization owning the project
+	Organization string `json:"organization"`
+
+	// project path `organization/project` as referenced by bob
+	Path string `json:"path"`
+
+	// public projects can be read without credentials
+	Public bool `json:"public"`
 }
 
 // ProjectCreate defines model for ProjectCreate.
 type ProjectCreate struct {
 	Description string `json:"description"`
-	Name        string `json:"name"`
+
+	// project name, or project path `organization/project`
+	Name string `json:"name"`
+
+	// name of the organization owning the project, the default organization if empty
+	Organization *string `json:"organization,omitempty"`
+}
+
+// ProjectUsage defines model for ProjectUsage.
+type ProjectUsage struct {
+
+	// number of artifacts
+	Artifacts int `json:"artifacts"`
+
+	// total size of all artifacts
+	Bytes int64 `json:"bytes"`
+
+	// maximum number of artifacts, 0 means unlimited
+	MaxArtifacts int `json:"maxArtifacts"`
+
+	// maximum total size of all artifacts, 0 means unlimited
+	MaxBytes int64 `json:"maxBytes"`
+}
+
+// Quota defines model for Quota.
+type Quota struct {
+
+	// maximum number of artifacts, 0 means unlimited
+	MaxArtifacts int `json:"maxArtifacts"`
+
+	// maximum total size of all artifacts, 0 means unlimited
+	MaxBytes int64 `json:"maxBytes"`
+}
+
+// RetentionPolicy defines model for RetentionPolicy.
+type RetentionPolicy struct {
+
+	// number of most recently used artifacts to keep
+	KeepLast int `json:"keepLast"`
+
+	// maximum age of an artifact since its creation
+	MaxAgeSeconds int64 `json:"maxAgeSeconds"`
+
+	// maximum total size of all artifacts
+	MaxBytes int64 `json:"maxBytes"`
 }
 
+// member to download artifacts, maintainer to upload and delete them, owner to manage projects and members of the organization
+type Role string
+
+// List of Role
+const (
+	Role_maintainer Role = "maintainer"
+	Role_member     Role = "member"
+	Role_owner      Role = "owner"
+)
+
 // Success defines model for Success.
 type Success struct {
 	Message string `json:"message"`
 }
 
+// Token defines model for Token.
+type Token struct {
+	CreatedAt time.Time  `json:"createdAt"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	Id        string     `json:"id"`
+	Name      string     `json:"name"`
+
+	// id of the project the token is restricted to
+	ProjectId *string `json:"projectId,omitempty"`
+
+	// read to download artifacts, write to upload and delete them, admin to manage projects and tokens
+	Scope TokenScope `json:"scope"`
+
+	// only returned when the token is created
+	Secret *string `json:"secret,omitempty"`
+
+	// id of the user the token acts for
+	UserId *string `json:"userId,omitempty"`
+}
+
+// TokenCreate defines model for TokenCreate.
+type TokenCreate struct {
+
+	// the token never expires if empty
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// unique name of the token
+	Name string `json:"name"`
+
+	// name of the project the token is restricted to, all projects if empty
+	Project *string `json:"project,omitempty"`
+
+	// read to download artifacts, write to upload and delete them, admin to manage projects and tokens
+	Scope TokenScope `json:"scope"`
+
+	// name of the user the token acts for, its scope is limited by the user's roles
+	User *string `json:"user,omitempty"`
+}
+
+// read to download artifacts, write to upload and delete them, admin to manage projects and tokens
+type TokenScope string
+
+// List of TokenScope
+const (
+	TokenScope_admin TokenScope = "admin"
+	TokenScope_read  TokenScope = "read"
+	TokenScope_write TokenScope = "write"
+)
+
+// Upload defines model for Upload.
+type Upload struct {
+	ArtifactId string `json:"artifactId"`
+
+	// chunks received so far
+	Chunks    []UploadChunk `json:"chunks"`
+	ExpiresAt time.Time     `json:"expiresAt"`
+	Id        string        `json:"id"`
+}
+
+// UploadChunk defines model for UploadChunk.
+type UploadChunk struct {
+	Number int   `json:"number"`
+	Size   int64 `json:"size"`
+}
+
+// User defines model for User.
+type User struct {
+	CreatedAt time.Time `json:"createdAt"`
+	Id        string    `json:"id"`
+	Name      string    `json:"name"`
+}
+
+// UserCreate defines model for UserCreate.
+type UserCreate struct {
+	Name string `json:"name"`
+}
+
+// Visibility defines model for Visibility.
+type Visibility struct {
+	Public bool `json:"public"`
+}
+
+// DownloadArtifactParams defines parameters for DownloadArtifact.
+type DownloadArtifactParams struct {
+
+	// unix timestamp until the link is valid
+	Expires int64 `json:"expires"`
+
+	// signature of the link
+	Signature string `json:"signature"`
+}
+
+// SetMemberJSONBody defines parameters for SetMember.
+type SetMemberJSONBody MemberSet
+
+// CreateOrganizationJSONBody defines parameters for CreateOrganization.
+type CreateOrganizationJSONBody OrganizationCreate
+
+// ProjectArtifactsExistJSONBody defines parameters for ProjectArtifactsExist.
+type ProjectArtifactsExistJSONBody ArtifactIds
+
+// CreateDirectUploadJSONBody defines parameters for CreateDirectUpload.
+type CreateDirectUploadJSONBody DirectUploadCreate
+
+// SetQuotaJSONBody defines parameters for SetQuota.
+type SetQuotaJSONBody Quota
+
+// SetRetentionPolicyJSONBody defines parameters for SetRetentionPolicy.
+type SetRetentionPolicyJSONBody RetentionPolicy
+
+// CreateUploadJSONBody defines parameters for CreateUpload.
+type CreateUploadJSONBody ArtifactCreate
+
+// SetVisibilityJSONBody defines parameters for SetVisibility.
+type SetVisibilityJSONBody Visibility
+
 // CreateProjectJSONBody defines parameters for CreateProject.
 type CreateProjectJSONBody ProjectCreate
 
+// ImportProjectParams defines parameters for ImportProject.
+type ImportProjectParams struct {
+
+	// path of the project to import to, defaults to the path stored in the archive
+	Project *string `json:"project,omitempty"`
+}
+
+// CreateTokenJSONBody defines parameters for CreateToken.
+type CreateTokenJSONBody TokenCreate
+
+// CreateUserJSONBody defines parameters for CreateUser.
+type CreateUserJSONBody UserCreate
+
+// SetMemberJSONRequestBody defines body for SetMember for application/json ContentType.
+type SetMemberJSONRequestBody SetMemberJSONBody
+
+// CreateOrganizationJSONRequestBody defines body for CreateOrganization for application/json ContentType.
+type CreateOrganizationJSONRequestBody CreateOrganizationJSONBody
+
+// ProjectArtifactsExistJSONRequestBody defines body for ProjectArtifactsExist for application/json ContentType.
+type ProjectArtifactsExistJSONRequestBody ProjectArtifactsExistJSONBody
+
+// CreateDirectUploadJSONRequestBody defines body for CreateDirectUpload for application/json ContentType.
+type CreateDirectUploadJSONRequestBody CreateDirectUploadJSONBody
+
+// SetQuotaJSONRequestBody defines body for SetQuota for application/json ContentType.
+type SetQuotaJSONRequestBody SetQuotaJSONBody
+
+// SetRetentionPolicyJSONRequestBody defines body for SetRetentionPolicy for application/json ContentType.
+type SetRetentionPolicyJSONRequestBody SetRetentionPolicyJSONBody
+
+// CreateUploadJSONRequestBody defines body for CreateUpload for application/json ContentType.
+type CreateUploadJSONRequestBody CreateUploadJSONBody
+
+// SetVisibilityJSONRequestBody defines body for SetVisibility for application/json ContentType.
+type SetVisibilityJSONRequestBody SetVisibilityJSONBody
+
 // CreateProjectJSONRequestBody defines body for CreateProject for application/json ContentType.
 type CreateProjectJSONRequestBody CreateProjectJSONBody
 
+// CreateTokenJSONRequestBody defines body for CreateToken for application/json ContentType.
+type CreateTokenJSONRequestBody CreateTokenJSONBody
+
+// CreateUserJSONRequestBody defines body for CreateUser for application/json ContentType.
+type CreateUserJSONRequestBody CreateUserJSONBody
+
+// Getter for additional properties for ArtifactsExist_Scopes. Returns the specified
+// element and whether it was found
+func (a ArtifactsExist_Scopes) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for ArtifactsExist_Scopes
+func (a *ArtifactsExist_Scopes) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for ArtifactsExist_Scopes to handle AdditionalProperties
+func (a *ArtifactsExist_Scopes) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return errors.Wrap(err, fmt.Sprintf("error unmarshaling field %s", fieldName))
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for ArtifactsExist_Scopes to handle AdditionalProperties
+func (a ArtifactsExist_Scopes) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("error marshaling '%s'", fieldName))
+		}
+	}
+	return json.Marshal(object)
+}
+
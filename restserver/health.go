@@ -3,6 +3,7 @@ package restserver
 import (
 	"net/http"
 
+	"github.com/benchkram/bobc/pkg/health"
 	"github.com/benchkram/bobc/restserver/generated"
 	"github.com/labstack/echo/v4"
 )
@@ -13,3 +14,25 @@ func (s *S) GetHealth(ctx echo.Context) error {
 	}
 	return ctx.JSON(http.StatusOK, res)
 }
+
+// GetLiveness reports that the server is running
+// (GET /api/health/live)
+func (s *S) GetLiveness(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, generated.Health{
+		Status: string(health.StatusUp),
+	})
+}
+
+// GetReadiness checks the dependencies of the server, it
+// responds with 503 Service Unavailable if any is down
+// (GET /api/health/ready)
+func (s *S) GetReadiness(ctx echo.Context) error {
+	r := s.app.Readiness(ctx.Request().Context())
+
+	code := http.StatusOK
+	if r.Status != health.StatusUp {
+		code = http.StatusServiceUnavailable
+	}
+
+	return ctx.JSON(code, r.ToRestType())
+}
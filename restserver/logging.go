@@ -0,0 +1,62 @@
+package restserver
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/benchkram/bobc/pkg/logging"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// probes are requested periodically, e.g. by kubernetes,
+// they are only logged on debug level if successful.
+var probes = map[string]bool{
+	"/api/health":       true,
+	"/api/health/live":  true,
+	"/api/health/ready": true,
+}
+
+// logRequests puts a logger on the request context adding the request id,
+// and the trace id if traced, to all lines logged for the request.
+// Every request is logged after it was handled.
+func logRequests(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		start := time.Now()
+		r := c.Request()
+
+		lc := log.With().Str(logging.FieldRequestID, c.Response().Header().Get(echo.HeaderXRequestID))
+		if sc := trace.SpanContextFromContext(r.Context()); sc.IsValid() {
+			lc = lc.Str(logging.FieldTraceID, sc.TraceID().String())
+		}
+		logger := lc.Logger()
+
+		c.SetRequest(r.WithContext(logger.WithContext(r.Context())))
+
+		err := next(c)
+
+		code := statusCode(c, err)
+
+		rt := route(c)
+
+		// client errors, e.g. missing artifacts, are part of normal operation
+		e := logger.Info()
+		switch {
+		case code >= http.StatusInternalServerError:
+			e = logger.Error()
+		case probes[rt] && code == http.StatusOK:
+			e = logger.Debug()
+		}
+		e.Str("method", r.Method).
+			Str("route", rt).
+			Str("path", r.URL.Path).
+			Int("status", code).
+			Int64("bytes", c.Response().Size).
+			Dur("latency", time.Since(start)).
+			Str("remote_ip", c.RealIP()).
+			Msg("Request handled.")
+
+		return err
+	}
+}
@@ -0,0 +1,76 @@
+package restserver
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/benchkram/bobc/pkg/metrics"
+	"github.com/labstack/echo/v4"
+)
+
+// GetMetrics serves the metrics in the prometheus text format
+// (GET /metrics)
+func (s *S) GetMetrics(ctx echo.Context) error {
+	return echo.WrapHandler(metrics.Handler())(ctx)
+}
+
+// observeRequests records the latency of requests by route.
+func observeRequests(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		start := time.Now()
+
+		err := next(c)
+
+		code := statusCode(c, err)
+		metrics.RequestDuration.
+			WithLabelValues(c.Request().Method, route(c), strconv.Itoa(code)).
+			Observe(time.Since(start).Seconds())
+
+		return err
+	}
+}
+
+// statusCode returns the status of the response to a request
+// handled with err, which is only written by the error handler.
+func statusCode(c echo.Context, err error) int {
+	if err == nil || c.Response().Committed {
+		return c.Response().Status
+	}
+
+	var he *echo.HTTPError
+	if errors.As(err, &he) {
+		return he.Code
+	}
+	return http.StatusInternalServerError
+}
+
+// route returns the path pattern a request matched. Requests not matching
+// any route are grouped to keep the number of series and span names bounded.
+func route(c echo.Context) string {
+	// echo reports the path of the request as route if none matched
+	r := c.Path()
+	if !registered(c.Echo(), r) {
+		return "unmatched"
+	}
+	return r
+}
+
+// routes caches the paths registered on an echo instance by instance,
+// they don't change once the server is running.
+var routes sync.Map
+
+// registered reports if path is the pattern of a route registered on e.
+func registered(e *echo.Echo, path string) bool {
+	v, ok := routes.Load(e)
+	if !ok {
+		paths := map[string]bool{}
+		for _, r := range e.Routes() {
+			paths[r.Path] = true
+		}
+		v, _ = routes.LoadOrStore(e, paths)
+	}
+	return v.(map[string]bool)[path]
+}
@@ -31,3 +31,9 @@ func WithAuthenticator(authn Authenticator) Option {
 		s.authenticator = authn
 	}
 }
+
+func WithDownloader(d Downloader) Option {
+	return func(s *S) {
+		s.downloader = d
+	}
+}
@@ -0,0 +1,187 @@
+package restserver
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/benchkram/bobc/application"
+	"github.com/benchkram/bobc/pkg/organization"
+	"github.com/benchkram/bobc/restserver/generated"
+	"github.com/benchkram/errz"
+	"github.com/labstack/echo/v4"
+)
+
+// GetOrganizations returns the organizations the caller can read
+// (GET /api/organizations)
+func (s *S) GetOrganizations(ctx echo.Context) (err error) {
+	defer errz.Recover(&err)
+
+	err = s.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+
+	orgs, err := s.app.Organizations(ctx.Request().Context())
+	if err != nil {
+		return appError(ctx, err)
+	}
+
+	result := []generated.Organization{}
+	for _, o := range orgs {
+		result = append(result, o.ToRestType())
+	}
+
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// CreateOrganization creates an organization
+// (POST /api/organizations)
+func (s *S) CreateOrganization(ctx echo.Context) (err error) {
+	defer errz.Recover(&err)
+
+	err = s.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+
+	body := generated.OrganizationCreate{}
+	err = ctx.Bind(&body)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, nil)
+	}
+
+	var description string
+	if body.Description != nil {
+		description = *body.Description
+	}
+
+	o, err := s.app.OrganizationCreate(ctx.Request().Context(), body.Name, description)
+	if errors.Is(err, application.ErrInvalidOrganizationName) {
+		return echo.NewHTTPError(http.StatusBadRequest, application.ErrInvalidOrganizationName.Error())
+	} else if errors.Is(err, application.ErrOrganizationAlreadyExists) {
+		return echo.NewHTTPError(http.StatusConflict, application.ErrOrganizationAlreadyExists.Error())
+	} else if err != nil {
+		return appError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, o.ToRestType())
+}
+
+// GetOrganization returns a single organization by name
+// (GET /api/organization/{organizationName})
+func (s *S) GetOrganization(ctx echo.Context, organizationName string) (err error) {
+	defer errz.Recover(&err)
+
+	err = s.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+
+	o, err := s.app.OrganizationByName(ctx.Request().Context(), organizationName)
+	if errors.Is(err, application.ErrOrganizationNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound)
+	} else if err != nil {
+		return appError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, o.ToRestType())
+}
+
+// DeleteOrganization deletes an organization without projects
+// (DELETE /api/organization/{organizationName})
+func (s *S) DeleteOrganization(ctx echo.Context, organizationName string) (err error) {
+	defer errz.Recover(&err)
+
+	err = s.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = s.app.OrganizationDelete(ctx.Request().Context(), organizationName)
+	if errors.Is(err, application.ErrOrganizationNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound)
+	} else if errors.Is(err, application.ErrOrganizationNotEmpty) {
+		return echo.NewHTTPError(http.StatusConflict, application.ErrOrganizationNotEmpty.Error())
+	} else if err != nil {
+		return appError(ctx, err)
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+// GetMembers returns the members of an organization
+// (GET /api/organization/{organizationName}/members)
+func (s *S) GetMembers(ctx echo.Context, organizationName string) (err error) {
+	defer errz.Recover(&err)
+
+	err = s.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+
+	members, err := s.app.Members(ctx.Request().Context(), organizationName)
+	if errors.Is(err, application.ErrOrganizationNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound)
+	} else if err != nil {
+		return appError(ctx, err)
+	}
+
+	result := []generated.Member{}
+	for _, m := range members {
+		result = append(result, m.ToRestType())
+	}
+
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// SetMember gives a user a role in an organization
+// (PUT /api/organization/{organizationName}/member/{userName})
+func (s *S) SetMember(ctx echo.Context, organizationName, userName string) (err error) {
+	defer errz.Recover(&err)
+
+	err = s.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+
+	body := generated.MemberSet{}
+	err = ctx.Bind(&body)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, nil)
+	}
+
+	m, err := s.app.MemberSet(ctx.Request().Context(), organizationName, userName, organization.Role(body.Role))
+	if errors.Is(err, application.ErrInvalidRole) {
+		return echo.NewHTTPError(http.StatusBadRequest, application.ErrInvalidRole.Error())
+	} else if errors.Is(err, application.ErrOrganizationNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound, application.ErrOrganizationNotFound.Error())
+	} else if errors.Is(err, application.ErrUserNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound, application.ErrUserNotFound.Error())
+	} else if err != nil {
+		return appError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, m.ToRestType())
+}
+
+// RemoveMember removes a user from an organization
+// (DELETE /api/organization/{organizationName}/member/{userName})
+func (s *S) RemoveMember(ctx echo.Context, organizationName, userName string) (err error) {
+	defer errz.Recover(&err)
+
+	err = s.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = s.app.MemberRemove(ctx.Request().Context(), organizationName, userName)
+	if errors.Is(err, application.ErrOrganizationNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound, application.ErrOrganizationNotFound.Error())
+	} else if errors.Is(err, application.ErrMemberNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound, application.ErrMemberNotFound.Error())
+	} else if err != nil {
+		return appError(ctx, err)
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
@@ -15,6 +15,9 @@ import (
 
 const (
 	HeaderBobExists = "Bob-Exists"
+	HeaderBobScope  = "Bob-Scope"
+	HeaderDigest    = "Digest"
+	HeaderETag      = "ETag"
 )
 
 // Returns a list of projects with name and ID, without hashes.
@@ -22,15 +25,14 @@ const (
 func (s *S) GetProjects(ctx echo.Context) (err error) {
 	defer errz.Recover(&err)
 
-	err = s.authenticator.Authenticate(ctx)
+	err = s.authenticateOrAnonymous(ctx)
 	if err != nil {
-		return ctx.NoContent(http.StatusUnauthorized)
+		return err
 	}
 
-	projects, err := s.app.Projects()
+	projects, err := s.app.Projects(ctx.Request().Context())
 	if err != nil {
-		errz.Log(err)
-		return echo.NewHTTPError(http.StatusInternalServerError)
+		return appError(ctx, err)
 	}
 
 	result := []generated.Project{}
@@ -48,9 +50,9 @@ func (s *S) GetProjects(ctx echo.Context) (err error) {
 func (s *S) CreateProject(ctx echo.Context) (err error) {
 	defer errz.Recover(&err)
 
-	err = s.authenticator.Authenticate(ctx)
+	err = s.authenticate(ctx)
 	if err != nil {
-		return ctx.NoContent(http.StatusUnauthorized)
+		return err
 	}
 
 	projectCreate := generated.ProjectCreate{}
@@ -63,14 +65,20 @@ func (s *S) CreateProject(ctx echo.Context) (err error) {
 		return ctx.JSON(http.StatusBadRequest, nil)
 	}
 
-	p, err := s.app.ProjectCreate(projectCreate.Name, projectCreate.Description)
-	if errors.Is(err, application.ErrProjectAlreadyExists) {
-		return ctx.NoContent(http.StatusBadRequest)
+	var org string
+	if projectCreate.Organization != nil {
+		org = *projectCreate.Organization
+	}
+
+	p, err := s.app.ProjectCreate(ctx.Request().Context(), org, projectCreate.Name, projectCreate.Description)
+	if errors.Is(err, application.ErrOrganizationNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound, application.ErrOrganizationNotFound.Error())
+	} else if errors.Is(err, application.ErrProjectAlreadyExists) {
+		return echo.NewHTTPError(http.StatusConflict, application.ErrProjectAlreadyExists.Error())
 	} else if errors.Is(err, application.ErrInvalidProjectName) {
 		return ctx.NoContent(http.StatusBadRequest)
 	} else if err != nil {
-		errz.Log(err)
-		return ctx.JSON(http.StatusInternalServerError, nil)
+		return appError(ctx, err)
 	}
 
 	return ctx.JSON(http.StatusOK, p.ToExtendedProjectRestType())
@@ -79,18 +87,17 @@ func (s *S) CreateProject(ctx echo.Context) (err error) {
 func (s *S) ProjectExists(ctx echo.Context, projectId string) (err error) {
 	defer errz.Recover(&err)
 
-	err = s.authenticator.Authenticate(ctx)
+	err = s.authenticateOrAnonymous(ctx)
 	if err != nil {
-		return ctx.NoContent(http.StatusUnauthorized)
+		return err
 	}
 
 	// rest requires all parameters for a route to be named equally.
 	projectName := projectId
 
-	exists, err := s.app.ProjectExists(projectName)
+	exists, err := s.app.ProjectExists(ctx.Request().Context(), projectName)
 	if err != nil {
-		errz.Log(err)
-		return ctx.JSON(http.StatusInternalServerError, nil)
+		return appError(ctx, err)
 	}
 
 	ctx.Response().Header().Set(HeaderBobExists, strconv.FormatBool(exists))
@@ -102,9 +109,9 @@ func (s *S) ProjectExists(ctx echo.Context, projectId string) (err error) {
 func (s *S) DeleteProject(ctx echo.Context, projectId string) (err error) {
 	defer errz.Recover(&err)
 
-	err = s.authenticator.Authenticate(ctx)
+	err = s.authenticate(ctx)
 	if err != nil {
-		return ctx.NoContent(http.StatusUnauthorized)
+		return err
 	}
 
 	pid, err := uuid.Parse(projectId)
@@ -112,20 +119,18 @@ func (s *S) DeleteProject(ctx echo.Context, projectId string) (err error) {
 		return ctx.JSON(http.StatusBadRequest, ErrInvalidProjectID.Error())
 	}
 
-	p, err := s.app.Project(pid)
+	p, err := s.app.Project(ctx.Request().Context(), pid)
 	if err != nil {
 		if errors.Is(err, projectRepo.ErrNotFound) {
 			return ctx.JSON(http.StatusNotFound, nil)
 		} else {
-			errz.Log(err)
-			return ctx.JSON(http.StatusInternalServerError, nil)
+			return appError(ctx, err)
 		}
 	}
 
-	err = s.app.ProjectDelete(p.ID)
+	err = s.app.ProjectDelete(ctx.Request().Context(), p.ID)
 	if err != nil {
-		errz.Log(err)
-		return ctx.JSON(http.StatusInternalServerError, nil)
+		return appError(ctx, err)
 	}
 
 	return ctx.JSON(http.StatusOK, nil)
@@ -136,9 +141,9 @@ func (s *S) DeleteProject(ctx echo.Context, projectId string) (err error) {
 func (s *S) GetProject(ctx echo.Context, projectId string) (err error) {
 	defer errz.Recover(&err)
 
-	err = s.authenticator.Authenticate(ctx)
+	err = s.authenticateOrAnonymous(ctx)
 	if err != nil {
-		return ctx.NoContent(http.StatusUnauthorized)
+		return err
 	}
 
 	pid, err := uuid.Parse(projectId)
@@ -146,15 +151,60 @@ func (s *S) GetProject(ctx echo.Context, projectId string) (err error) {
 		return ctx.JSON(http.StatusBadRequest, ErrInvalidProjectID)
 	}
 
-	project, err := s.app.Project(pid)
+	project, err := s.app.Project(ctx.Request().Context(), pid)
 	if err != nil {
 		if errors.Is(err, application.ErrProjectNotFound) {
 			return ctx.NoContent(http.StatusNotFound)
 		} else {
-			errz.Log(err)
-			return ctx.JSON(http.StatusInternalServerError, nil)
+			return appError(ctx, err)
 		}
 	}
 
-	return ctx.JSON(http.StatusOK, project.ToExtendedProjectRestType())
+	usage, err := s.projectUsage(ctx.Request().Context(), pid)
+	if err != nil {
+		return appError(ctx, err)
+	}
+
+	p := project.ToExtendedProjectRestType()
+	p.Usage = &usage
+
+	return ctx.JSON(http.StatusOK, p)
+}
+
+// SetVisibility makes a project public or private
+// (PUT /api/project/{projectName}/visibility)
+func (s *S) SetVisibility(ctx echo.Context, projectName string) (err error) {
+	defer errz.Recover(&err)
+
+	err = s.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+
+	visibility := generated.Visibility{}
+	err = ctx.Bind(&visibility)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, nil)
+	}
+
+	projectID, err := s.app.ProjectIDByName(ctx.Request().Context(), projectName)
+	if errors.Is(err, application.ErrProjectNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound)
+	} else if err != nil {
+		return appError(ctx, err)
+	}
+
+	err = s.app.ProjectVisibilitySet(ctx.Request().Context(), projectID, visibility.Public)
+	if errors.Is(err, application.ErrProjectNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound)
+	} else if err != nil {
+		return appError(ctx, err)
+	}
+
+	p, err := s.app.Project(ctx.Request().Context(), projectID)
+	if err != nil {
+		return appError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, p.ToProjectRestType())
 }
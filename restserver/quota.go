@@ -0,0 +1,90 @@
+package restserver
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/benchkram/bobc/application"
+	"github.com/benchkram/bobc/pkg/quota"
+	"github.com/benchkram/bobc/restserver/generated"
+	"github.com/benchkram/errz"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// GetQuota returns the storage quota of a project and its usage
+// (GET /api/project/{projectName}/quota)
+func (s *S) GetQuota(ctx echo.Context, projectName string) (err error) {
+	defer errz.Recover(&err)
+
+	err = s.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+
+	projectID, err := s.app.ProjectIDByName(ctx.Request().Context(), projectName)
+	if errors.Is(err, application.ErrProjectNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound)
+	} else if err != nil {
+		return appError(ctx, err)
+	}
+
+	usage, err := s.projectUsage(ctx.Request().Context(), projectID)
+	if err != nil {
+		return appError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, usage)
+}
+
+// SetQuota sets the storage quota of a project
+// (PUT /api/project/{projectName}/quota)
+func (s *S) SetQuota(ctx echo.Context, projectName string) (err error) {
+	defer errz.Recover(&err)
+
+	err = s.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+
+	q := generated.Quota{}
+	err = ctx.Bind(&q)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, nil)
+	}
+
+	projectID, err := s.app.ProjectIDByName(ctx.Request().Context(), projectName)
+	if errors.Is(err, application.ErrProjectNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound)
+	} else if err != nil {
+		return appError(ctx, err)
+	}
+
+	err = s.app.QuotaSet(ctx.Request().Context(), quota.FromRestType(projectID, q))
+	if errors.Is(err, application.ErrInvalidQuota) {
+		return echo.NewHTTPError(http.StatusBadRequest, application.ErrInvalidQuota.Error())
+	} else if err != nil {
+		return appError(ctx, err)
+	}
+
+	usage, err := s.projectUsage(ctx.Request().Context(), projectID)
+	if err != nil {
+		return appError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, usage)
+}
+
+// projectUsage reports the usage of a project against its quota.
+func (s *S) projectUsage(ctx context.Context, projectID uuid.UUID) (_ generated.ProjectUsage, err error) {
+	defer errz.Recover(&err)
+
+	q, err := s.app.Quota(ctx, projectID)
+	errz.Fatal(err)
+
+	u, err := s.app.ProjectUsage(ctx, projectID)
+	errz.Fatal(err)
+
+	return q.ToUsageRestType(u), nil
+}
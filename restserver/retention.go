@@ -0,0 +1,71 @@
+package restserver
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/benchkram/bobc/application"
+	"github.com/benchkram/bobc/pkg/retention"
+	"github.com/benchkram/bobc/restserver/generated"
+	"github.com/benchkram/errz"
+	"github.com/labstack/echo/v4"
+)
+
+// GetRetentionPolicy returns the retention policy of a project
+// (GET /api/project/{projectName}/retention)
+func (s *S) GetRetentionPolicy(ctx echo.Context, projectName string) (err error) {
+	defer errz.Recover(&err)
+
+	err = s.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+
+	projectID, err := s.app.ProjectIDByName(ctx.Request().Context(), projectName)
+	if errors.Is(err, application.ErrProjectNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound)
+	} else if err != nil {
+		return appError(ctx, err)
+	}
+
+	p, err := s.app.RetentionPolicy(ctx.Request().Context(), projectID)
+	if err != nil {
+		return appError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, p.ToRestType())
+}
+
+// SetRetentionPolicy sets the retention policy of a project
+// (PUT /api/project/{projectName}/retention)
+func (s *S) SetRetentionPolicy(ctx echo.Context, projectName string) (err error) {
+	defer errz.Recover(&err)
+
+	err = s.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+
+	policy := generated.RetentionPolicy{}
+	err = ctx.Bind(&policy)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, nil)
+	}
+
+	projectID, err := s.app.ProjectIDByName(ctx.Request().Context(), projectName)
+	if errors.Is(err, application.ErrProjectNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound)
+	} else if err != nil {
+		return appError(ctx, err)
+	}
+
+	p := retention.FromRestType(projectID, policy)
+	err = s.app.RetentionPolicySet(ctx.Request().Context(), p)
+	if errors.Is(err, application.ErrInvalidRetentionPolicy) {
+		return echo.NewHTTPError(http.StatusBadRequest, application.ErrInvalidRetentionPolicy.Error())
+	} else if err != nil {
+		return appError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, p.ToRestType())
+}
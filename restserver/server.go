@@ -1,6 +1,7 @@
 package restserver
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
@@ -8,12 +9,14 @@ import (
 	"strings"
 
 	"github.com/benchkram/bobc/application"
+	"github.com/benchkram/bobc/pkg/principal"
+	"github.com/benchkram/bobc/pkg/scope"
 
 	"github.com/benchkram/bobc/restserver/generated"
 	"github.com/benchkram/errz"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
-	"github.com/logrusorgru/aurora"
+	"github.com/rs/zerolog/log"
 )
 
 var (
@@ -25,6 +28,7 @@ var (
 	ErrServerNotStarted = fmt.Errorf("Server not started yet")
 
 	ErrInvalidProjectID = fmt.Errorf("Project ID is not in valid format")
+	ErrInvalidScope     = fmt.Errorf("Scope is not in valid format")
 )
 
 var (
@@ -33,8 +37,17 @@ var (
 	DefaultUploadDir = filepath.Join(os.TempDir(), "./upload")
 )
 
+// Authenticator checks the credentials of a request
+// and returns the principal making the request.
 type Authenticator interface {
-	Authenticate(ctx echo.Context) (err error)
+	Authenticate(ctx echo.Context) (*principal.P, error)
+}
+
+// Downloader serves artifacts through links signed by bobc itself.
+// Used when artifacts are not stored in S3 and can't be presigned.
+type Downloader interface {
+	VerifyLink(id string, expires int64, signature string) error
+	Open(id string) (*os.File, error)
 }
 
 type S struct {
@@ -54,6 +67,10 @@ type S struct {
 	uploadDir string
 
 	authenticator Authenticator
+
+	// downloader is optional and only set when
+	// artifacts are stored on the local filesystem.
+	downloader Downloader
 }
 
 func New(opts ...Option) (s *S, err error) {
@@ -72,6 +89,84 @@ func New(opts ...Option) (s *S, err error) {
 	return s, nil
 }
 
+// principalKey is the key of the principal on the echo context.
+const principalKey = "principal"
+
+// authenticate rejects requests failing authentication. The principal
+// is stored on the echo context and on the request context, which is
+// passed on to the application.
+func (s *S) authenticate(ctx echo.Context) error {
+	p, err := s.authenticator.Authenticate(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized)
+	}
+
+	s.setPrincipal(ctx, p)
+
+	return nil
+}
+
+// authenticateOrAnonymous is like authenticate, but lets requests without
+// credentials pass as anonymous principal. Used by read only routes which
+// are allowed on public projects, invalid credentials are still rejected.
+func (s *S) authenticateOrAnonymous(ctx echo.Context) error {
+	if ctx.Request().Header.Get(echo.HeaderAuthorization) != "" {
+		return s.authenticate(ctx)
+	}
+
+	s.setPrincipal(ctx, principal.Anonymous())
+
+	return nil
+}
+
+// readScope puts the scope of an artifact request on the request context.
+// It's passed in the `Bob-Scope` header or the `scope` query parameter,
+// requests without scope use the default scope.
+func (s *S) readScope(ctx echo.Context) error {
+	sc := ctx.Request().Header.Get(HeaderBobScope)
+	if sc == "" {
+		sc = ctx.QueryParam("scope")
+	}
+
+	if !scope.Valid(sc) {
+		return echo.NewHTTPError(http.StatusBadRequest, ErrInvalidScope.Error())
+	}
+
+	r := ctx.Request()
+	ctx.SetRequest(r.WithContext(scope.NewContext(r.Context(), sc)))
+
+	return nil
+}
+
+// setScopeHeader reports the scope an artifact was found in,
+// nothing is reported for the default scope.
+func setScopeHeader(ctx echo.Context, sc string) {
+	if sc != scope.Default {
+		ctx.Response().Header().Set(HeaderBobScope, sc)
+	}
+}
+
+// setPrincipal makes p available to handlers and the application.
+func (s *S) setPrincipal(ctx echo.Context, p *principal.P) {
+	ctx.Set(principalKey, p)
+	r := ctx.Request()
+	ctx.SetRequest(r.WithContext(principal.NewContext(r.Context(), p)))
+}
+
+// appError converts an error returned by the application which
+// isn't handled by a handler itself. Unexpected errors are logged.
+func appError(ctx echo.Context, err error) error {
+	switch {
+	case errors.Is(err, application.ErrForbidden):
+		return echo.NewHTTPError(http.StatusForbidden, application.ErrForbidden.Error())
+	case errors.Is(err, application.ErrUnauthenticated):
+		return echo.NewHTTPError(http.StatusUnauthorized)
+	default:
+		log.Ctx(ctx.Request().Context()).Error().Err(err).Msg("Request failed.")
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+}
+
 var once bool
 
 func (s *S) checkIfApplicationExists(next echo.HandlerFunc) echo.HandlerFunc {
@@ -96,8 +191,10 @@ func (s *S) Start() (err error) {
 	e := echo.New()
 	e.Debug = true
 
-	// FIXME: Configure logging, NOT to stdout.
-	e.Use(middleware.Logger())
+	e.Use(middleware.RequestID())
+	e.Use(traceRequests)
+	e.Use(logRequests)
+	e.Use(observeRequests)
 	e.Use(middleware.Recover())
 	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowOrigins: []string{"*"},
@@ -165,7 +262,7 @@ func (s *S) Start() (err error) {
 				err = c.JSON(code, message)
 			}
 			if err != nil {
-				e.Logger.Error(err)
+				log.Ctx(c.Request().Context()).Error().Err(err).Msg("Sending error response failed.")
 			}
 		}
 	}
@@ -173,11 +270,16 @@ func (s *S) Start() (err error) {
 	e.HideBanner = true
 	e.HidePort = true
 
-	go func() { aurora.Red(e.Start(s.address)) }()
+	go func() {
+		err := e.Start(s.address)
+		if !errors.Is(err, http.ErrServerClosed) {
+			log.Error().Err(err).Str("address", s.address).Msg("Server failed.")
+		}
+	}()
 
-	fmt.Printf("\n%s\n", aurora.Green("Server started at "+s.address))
+	log.Info().Str("address", s.address).Msg("Server started.")
 	for _, route := range e.Routes() {
-		fmt.Printf("[%5v] %s\n", route.Method, route.Path)
+		log.Debug().Str("method", route.Method).Str("route", route.Path).Msg("Route registered.")
 	}
 
 	s.server = e.Server
@@ -196,7 +298,7 @@ func (s *S) Stop() (err error) {
 	}
 
 	once = false
-	fmt.Printf("\n%s\n", aurora.Red("Server is shutting down from "+s.address))
+	log.Info().Str("address", s.address).Msg("Server is shutting down.")
 
 	return nil
 }
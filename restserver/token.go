@@ -0,0 +1,127 @@
+package restserver
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/benchkram/bobc/application"
+	"github.com/benchkram/bobc/pkg/token"
+	"github.com/benchkram/bobc/restserver/generated"
+	"github.com/benchkram/errz"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// GetTokens returns all api tokens without their secrets
+// (GET /api/tokens)
+func (s *S) GetTokens(ctx echo.Context) (err error) {
+	defer errz.Recover(&err)
+
+	err = s.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+
+	tokens, err := s.app.Tokens(ctx.Request().Context())
+	if err != nil {
+		return appError(ctx, err)
+	}
+
+	result := []generated.Token{}
+	for _, t := range tokens {
+		result = append(result, t.ToRestType())
+	}
+
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// CreateToken creates an api token and returns it along with its secret
+// (POST /api/tokens)
+func (s *S) CreateToken(ctx echo.Context) (err error) {
+	defer errz.Recover(&err)
+
+	err = s.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+
+	body := generated.TokenCreate{}
+	err = ctx.Bind(&body)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, nil)
+	}
+
+	projectID := uuid.Nil
+	if body.Project != nil && *body.Project != "" {
+		projectID, err = s.app.ProjectIDByName(ctx.Request().Context(), *body.Project)
+		if errors.Is(err, application.ErrProjectNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, application.ErrProjectNotFound.Error())
+		} else if err != nil {
+			return appError(ctx, err)
+		}
+	}
+
+	userID := uuid.Nil
+	if body.User != nil && *body.User != "" {
+		u, err := s.app.UserByName(ctx.Request().Context(), *body.User)
+		if errors.Is(err, application.ErrUserNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, application.ErrUserNotFound.Error())
+		} else if err != nil {
+			return appError(ctx, err)
+		}
+		userID = u.ID
+	}
+
+	var expiresAt time.Time
+	if body.ExpiresAt != nil {
+		expiresAt = *body.ExpiresAt
+	}
+
+	t, secret, err := s.app.TokenCreate(ctx.Request().Context(), body.Name, token.Scope(body.Scope), projectID, userID, expiresAt)
+	if errors.Is(err, application.ErrTokenAlreadyExists) {
+		return echo.NewHTTPError(http.StatusConflict, application.ErrTokenAlreadyExists.Error())
+	} else if errors.Is(err, application.ErrInvalidTokenName) {
+		return echo.NewHTTPError(http.StatusBadRequest, application.ErrInvalidTokenName.Error())
+	} else if errors.Is(err, application.ErrInvalidTokenScope) {
+		return echo.NewHTTPError(http.StatusBadRequest, application.ErrInvalidTokenScope.Error())
+	} else if errors.Is(err, application.ErrInvalidTokenExpiry) {
+		return echo.NewHTTPError(http.StatusBadRequest, application.ErrInvalidTokenExpiry.Error())
+	} else if errors.Is(err, application.ErrProjectNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound, application.ErrProjectNotFound.Error())
+	} else if errors.Is(err, application.ErrUserNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound, application.ErrUserNotFound.Error())
+	} else if err != nil {
+		return appError(ctx, err)
+	}
+
+	result := t.ToRestType()
+	result.Secret = &secret
+
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// RevokeToken deletes an api token
+// (DELETE /api/token/{tokenId})
+func (s *S) RevokeToken(ctx echo.Context, tokenId string) (err error) {
+	defer errz.Recover(&err)
+
+	err = s.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.Parse(tokenId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest)
+	}
+
+	err = s.app.TokenRevoke(ctx.Request().Context(), id)
+	if errors.Is(err, application.ErrTokenNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound)
+	} else if err != nil {
+		return appError(ctx, err)
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
@@ -0,0 +1,35 @@
+package restserver
+
+import (
+	"github.com/benchkram/bobc/pkg/tracing"
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.7.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// traceRequests starts a span for every request. It continues the trace
+// of the client if trace context headers, e.g. `traceparent`, are sent.
+func traceRequests(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		r := c.Request()
+		ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+
+		ctx, span := tracing.Start(ctx, "HTTP "+r.Method, trace.WithSpanKind(trace.SpanKindServer))
+		defer span.End()
+
+		c.SetRequest(r.WithContext(ctx))
+
+		err := next(c)
+
+		code := statusCode(c, err)
+		rt := route(c)
+		span.SetName(r.Method + " " + rt)
+		span.SetAttributes(semconv.HTTPServerAttributesFromHTTPRequest("bobc", rt, r)...)
+		span.SetAttributes(semconv.HTTPAttributesFromHTTPStatusCode(code)...)
+		span.SetStatus(semconv.SpanStatusFromHTTPStatusCodeAndSpanKind(code, trace.SpanKindServer))
+
+		return err
+	}
+}
@@ -0,0 +1,266 @@
+package restserver
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/benchkram/bobc/application"
+	"github.com/benchkram/bobc/pkg/metrics"
+	"github.com/benchkram/bobc/restserver/generated"
+	"github.com/benchkram/errz"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// CreateUpload starts a resumable upload of an artifact
+// (POST /api/project/{projectName}/uploads)
+func (s *S) CreateUpload(ctx echo.Context, projectName string) (err error) {
+	defer errz.Recover(&err)
+
+	err = s.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = s.readScope(ctx)
+	if err != nil {
+		return err
+	}
+
+	artifactCreate := generated.ArtifactCreate{}
+	err = ctx.Bind(&artifactCreate)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, nil)
+	}
+
+	if artifactCreate.Id == "" || len(artifactCreate.Id) > maxArtifactIDLength {
+		return ctx.JSON(http.StatusBadRequest, nil)
+	}
+
+	p, err := s.app.ProjectByName(ctx.Request().Context(), projectName)
+	if errors.Is(err, application.ErrProjectNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound)
+	} else if err != nil {
+		return appError(ctx, err)
+	}
+
+	var digest string
+	if artifactCreate.Digest != nil {
+		digest = *artifactCreate.Digest
+	}
+
+	u, err := s.app.UploadCreate(ctx.Request().Context(), p.ID, artifactCreate.Id, digest)
+	if errors.Is(err, application.ErrArtifactAlreadyExists) {
+		return echo.NewHTTPError(http.StatusConflict, application.ErrArtifactAlreadyExists)
+	} else if errors.Is(err, application.ErrQuotaExceeded) {
+		return echo.NewHTTPError(http.StatusInsufficientStorage, application.ErrQuotaExceeded.Error())
+	} else if errors.Is(err, application.ErrInvalidDigest) {
+		return echo.NewHTTPError(http.StatusBadRequest, application.ErrInvalidDigest.Error())
+	} else if err != nil {
+		return appError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, u.ToRestType())
+}
+
+// CreateDirectUpload starts an upload which the client sends directly
+// to the artifact store using the returned presigned links
+// (POST /api/project/{projectName}/direct-uploads)
+func (s *S) CreateDirectUpload(ctx echo.Context, projectName string) (err error) {
+	defer errz.Recover(&err)
+
+	err = s.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = s.readScope(ctx)
+	if err != nil {
+		return err
+	}
+
+	directUploadCreate := generated.DirectUploadCreate{}
+	err = ctx.Bind(&directUploadCreate)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, nil)
+	}
+
+	if directUploadCreate.Id == "" || len(directUploadCreate.Id) > maxArtifactIDLength {
+		return ctx.JSON(http.StatusBadRequest, nil)
+	}
+
+	parts := 1
+	if directUploadCreate.Parts != nil {
+		parts = *directUploadCreate.Parts
+	}
+
+	p, err := s.app.ProjectByName(ctx.Request().Context(), projectName)
+	if errors.Is(err, application.ErrProjectNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound)
+	} else if err != nil {
+		return appError(ctx, err)
+	}
+
+	var digest string
+	if directUploadCreate.Digest != nil {
+		digest = *directUploadCreate.Digest
+	}
+
+	u, err := s.app.DirectUploadCreate(ctx.Request().Context(), p.ID, directUploadCreate.Id, digest, parts)
+	if errors.Is(err, application.ErrArtifactAlreadyExists) {
+		return echo.NewHTTPError(http.StatusConflict, application.ErrArtifactAlreadyExists)
+	} else if errors.Is(err, application.ErrQuotaExceeded) {
+		return echo.NewHTTPError(http.StatusInsufficientStorage, application.ErrQuotaExceeded.Error())
+	} else if errors.Is(err, application.ErrInvalidDigest) {
+		return echo.NewHTTPError(http.StatusBadRequest, application.ErrInvalidDigest.Error())
+	} else if errors.Is(err, application.ErrInvalidPartNumber) {
+		return echo.NewHTTPError(http.StatusBadRequest, application.ErrInvalidPartNumber)
+	} else if errors.Is(err, application.ErrDirectUploadUnsupported) {
+		return echo.NewHTTPError(http.StatusNotImplemented, application.ErrDirectUploadUnsupported)
+	} else if err != nil {
+		return appError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, u.ToDirectRestType())
+}
+
+// GetUpload returns the state of an upload
+// (GET /api/project/{projectName}/upload/{uploadId})
+func (s *S) GetUpload(ctx echo.Context, projectName, uploadId string) (err error) {
+	defer errz.Recover(&err)
+
+	err = s.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+
+	projectID, uploadID, err := s.uploadSession(ctx, projectName, uploadId)
+	if err != nil {
+		return err
+	}
+
+	u, err := s.app.Upload(ctx.Request().Context(), projectID, uploadID)
+	if errors.Is(err, application.ErrUploadNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound)
+	} else if err != nil {
+		return appError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, u.ToRestType())
+}
+
+// AbortUpload discards an upload and the chunks received so far
+// (DELETE /api/project/{projectName}/upload/{uploadId})
+func (s *S) AbortUpload(ctx echo.Context, projectName, uploadId string) (err error) {
+	defer errz.Recover(&err)
+
+	err = s.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+
+	projectID, uploadID, err := s.uploadSession(ctx, projectName, uploadId)
+	if err != nil {
+		return err
+	}
+
+	err = s.app.UploadAbort(ctx.Request().Context(), projectID, uploadID)
+	if errors.Is(err, application.ErrUploadNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound)
+	} else if err != nil {
+		return appError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, nil)
+}
+
+// UploadChunk stores a chunk of an upload
+// (PUT /api/project/{projectName}/upload/{uploadId}/chunk/{chunkNumber})
+func (s *S) UploadChunk(ctx echo.Context, projectName, uploadId string, chunkNumber int) (err error) {
+	defer errz.Recover(&err)
+
+	err = s.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+
+	// s3 requires the size of a part to be known upfront
+	if ctx.Request().ContentLength < 0 {
+		return ctx.NoContent(http.StatusLengthRequired)
+	}
+
+	projectID, uploadID, err := s.uploadSession(ctx, projectName, uploadId)
+	if err != nil {
+		return err
+	}
+
+	err = s.app.UploadPart(ctx.Request().Context(), projectID, uploadID, chunkNumber, ctx.Request().Body, ctx.Request().ContentLength)
+	if errors.Is(err, application.ErrUploadNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound)
+	} else if errors.Is(err, application.ErrInvalidPartNumber) {
+		return echo.NewHTTPError(http.StatusBadRequest, application.ErrInvalidPartNumber)
+	} else if errors.Is(err, application.ErrUploadDirect) {
+		return echo.NewHTTPError(http.StatusConflict, application.ErrUploadDirect)
+	} else if errors.Is(err, application.ErrQuotaExceeded) {
+		return echo.NewHTTPError(http.StatusInsufficientStorage, application.ErrQuotaExceeded.Error())
+	} else if err != nil {
+		return appError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, nil)
+}
+
+// CompleteUpload assembles the artifact from the chunks of an upload
+// (POST /api/project/{projectName}/upload/{uploadId}/complete)
+func (s *S) CompleteUpload(ctx echo.Context, projectName, uploadId string) (err error) {
+	defer errz.Recover(&err)
+
+	err = s.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+
+	projectID, uploadID, err := s.uploadSession(ctx, projectName, uploadId)
+	if err != nil {
+		return err
+	}
+
+	a, err := s.app.UploadComplete(ctx.Request().Context(), projectID, uploadID)
+	if errors.Is(err, application.ErrUploadNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound)
+	} else if errors.Is(err, application.ErrUploadIncomplete) {
+		return echo.NewHTTPError(http.StatusBadRequest, application.ErrUploadIncomplete)
+	} else if errors.Is(err, application.ErrDigestMismatch) {
+		return echo.NewHTTPError(http.StatusBadRequest, application.ErrDigestMismatch.Error())
+	} else if errors.Is(err, application.ErrQuotaExceeded) {
+		return echo.NewHTTPError(http.StatusInsufficientStorage, application.ErrQuotaExceeded.Error())
+	} else if errors.Is(err, application.ErrArtifactAlreadyExists) {
+		return echo.NewHTTPError(http.StatusConflict, application.ErrArtifactAlreadyExists)
+	} else if errors.Is(err, application.ErrDirectUploadUnsupported) {
+		return echo.NewHTTPError(http.StatusNotImplemented, application.ErrDirectUploadUnsupported)
+	} else if err != nil {
+		return appError(ctx, err)
+	}
+
+	metrics.Upload(a.Size)
+
+	return ctx.JSON(http.StatusOK, a.ToRestType())
+}
+
+// uploadSession resolves the project and parses the id of an upload.
+// The returned error is meant to be returned by the handler.
+func (s *S) uploadSession(ctx echo.Context, projectName, uploadId string) (projectID, uploadID uuid.UUID, err error) {
+	uploadID, err = uuid.Parse(uploadId)
+	if err != nil {
+		return projectID, uploadID, echo.NewHTTPError(http.StatusNotFound)
+	}
+
+	p, err := s.app.ProjectByName(ctx.Request().Context(), projectName)
+	if errors.Is(err, application.ErrProjectNotFound) {
+		return projectID, uploadID, echo.NewHTTPError(http.StatusNotFound)
+	} else if err != nil {
+		return projectID, uploadID, appError(ctx, err)
+	}
+
+	return p.ID, uploadID, nil
+}
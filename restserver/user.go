@@ -0,0 +1,82 @@
+package restserver
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/benchkram/bobc/application"
+	"github.com/benchkram/bobc/restserver/generated"
+	"github.com/benchkram/errz"
+	"github.com/labstack/echo/v4"
+)
+
+// GetUsers returns all users
+// (GET /api/users)
+func (s *S) GetUsers(ctx echo.Context) (err error) {
+	defer errz.Recover(&err)
+
+	err = s.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+
+	users, err := s.app.Users(ctx.Request().Context())
+	if err != nil {
+		return appError(ctx, err)
+	}
+
+	result := []generated.User{}
+	for _, u := range users {
+		result = append(result, u.ToRestType())
+	}
+
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// CreateUser creates a user
+// (POST /api/users)
+func (s *S) CreateUser(ctx echo.Context) (err error) {
+	defer errz.Recover(&err)
+
+	err = s.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+
+	body := generated.UserCreate{}
+	err = ctx.Bind(&body)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, nil)
+	}
+
+	u, err := s.app.UserCreate(ctx.Request().Context(), body.Name)
+	if errors.Is(err, application.ErrInvalidUsername) {
+		return echo.NewHTTPError(http.StatusBadRequest, application.ErrInvalidUsername.Error())
+	} else if errors.Is(err, application.ErrUserAlreadyExists) {
+		return echo.NewHTTPError(http.StatusConflict, application.ErrUserAlreadyExists.Error())
+	} else if err != nil {
+		return appError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, u.ToRestType())
+}
+
+// DeleteUser deletes a user along with its memberships and api tokens
+// (DELETE /api/user/{userName})
+func (s *S) DeleteUser(ctx echo.Context, userName string) (err error) {
+	defer errz.Recover(&err)
+
+	err = s.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = s.app.UserDelete(ctx.Request().Context(), userName)
+	if errors.Is(err, application.ErrUserNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound)
+	} else if err != nil {
+		return appError(ctx, err)
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
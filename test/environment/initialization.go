@@ -6,7 +6,9 @@ import (
 
 	"github.com/benchkram/bobc/application"
 	"github.com/benchkram/bobc/pkg/artifactstore"
+	"github.com/benchkram/bobc/pkg/orgrepo"
 	"github.com/benchkram/bobc/pkg/projectrepo"
+	"github.com/benchkram/bobc/pkg/tokenrepo"
 	"github.com/benchkram/bobc/restserver/authenticator"
 
 	database "github.com/benchkram/bobc/pkg/db"
@@ -73,9 +75,13 @@ func InitApp(conf *database.Config, minioConfig MinIOConfig) (_ application.Appl
 	)
 
 	projectRepo := projectrepo.New(db, artifactStore)
+	tokenRepo := tokenrepo.New(db)
+	orgRepo := orgrepo.New(db)
 
 	app := application.New(
 		application.WithProjectRepository(projectRepo),
+		application.WithTokenRepository(tokenRepo),
+		application.WithOrganizationRepository(orgRepo),
 	)
 
 	return app, nil
@@ -90,7 +96,7 @@ func InitRestServer(app application.Application, apiKey []byte) (*restserver.S,
 	}
 
 	// create rest-server
-	authn := authenticator.New(apiKey)
+	authn := authenticator.New(apiKey, authenticator.WithTokens(app))
 
 	// create rest-server
 	s, err := restserver.New(